@@ -4,23 +4,35 @@ package useragent
 
 import (
 	"net/url"
+	"regexp"
+	"strings"
 
 	"github.com/blang/semver"
 )
 
 // Keep them sorted
 var browsers = map[string]*url.URL{
-	"Chrome":    u("http://www.chromium.org/"),
-	"Dillo":     u("http://www.dillo.org/"),
-	"Edge":      u("https://www.microsoft.com/en-us/windows/microsoft-edge"),
-	"Firefox":   u("https://www.mozilla.org/en-US/firefox"),
-	"IceCat":    u("https://www.gnu.org/software/gnuzilla/"),
-	"Iceweasel": u("https://wiki.debian.org/Iceweasel"),
-	"NetSurf":   u("http://www.netsurf-browser.org/"),
-	"Opera":     u("http://www.opera.com/"),
-	"PhantomJS": u("http://phantomjs.org/"),
-	"Silk":      u("http://aws.amazon.com/documentation/silk/"),
-	"WebView":   u("http://developer.android.com/guide/webapps/webview.html"),
+	"Chrome":           u("http://www.chromium.org/"),
+	"Dillo":            u("http://www.dillo.org/"),
+	"DuckDuckGo":       u("https://duckduckgo.com/app"),
+	"Edge":             u("https://www.microsoft.com/en-us/windows/microsoft-edge"),
+	"Firefox":          u("https://www.mozilla.org/en-US/firefox"),
+	"Huawei Browser":   u("https://consumer.huawei.com/en/mobileservices/browser/"),
+	"IceCat":           u("https://www.gnu.org/software/gnuzilla/"),
+	"Iceweasel":        u("https://wiki.debian.org/Iceweasel"),
+	"MIUI Browser":     u("https://global.miui.com/"),
+	"NetSurf":          u("http://www.netsurf-browser.org/"),
+	"Opera":            u("http://www.opera.com/"),
+	"Pale Moon":        u("https://www.palemoon.org/"),
+	"PhantomJS":        u("http://phantomjs.org/"),
+	"QQ Browser":       u("https://browser.qq.com/"),
+	"Samsung Internet": u("https://www.samsung.com/us/support/owners/app/samsung-internet"),
+	"Silk":             u("http://aws.amazon.com/documentation/silk/"),
+	"UC Browser":       u("https://www.ucweb.com/"),
+	"Vivaldi":          u("https://vivaldi.com/"),
+	"WebView":          u("http://developer.android.com/guide/webapps/webview.html"),
+	"Whale":            u("https://whale.naver.com/"),
+	"Yandex Browser":   u("https://browser.yandex.com/"),
 }
 
 const (
@@ -31,9 +43,36 @@ const (
 	OSWindows = "Windows"
 )
 
+const (
+	EngineBlink    = "Blink"
+	EngineEdgeHTML = "EdgeHTML"
+	EngineGecko    = "Gecko"
+	EngineGoanna   = "Goanna"
+	EnginePresto   = "Presto"
+	EngineTrident  = "Trident"
+	EngineWebKit   = "WebKit"
+)
+
+// Engine version tokens, anywhere in the UA string
+var rvRegexp = regexp.MustCompile(`\brv:(\d+(?:\.\d+)*)`)
+var tridentRegexp = regexp.MustCompile(`\bTrident/(\d+(?:\.\d+)*)`)
+var chromeRegexp = regexp.MustCompile(`(?:\b|Headless)Chrome/(\d+(?:\.\d+)*)`)
+var edgeHTMLRegexp = regexp.MustCompile(`\bEdge/(\d+(?:\.\d+)*)`)
+
+// Find the version captured by re anywhere in the UA string
+func findVersion(l *lex, re *regexp.Regexp) (semver.Version, bool) {
+	_, s, ok := newLex(l.s).spanRegexp(re)
+	if !ok {
+		return semver.Version{}, false
+	}
+	return lexVersion(newLex(s), " ")
+}
+
 func parseBrowser(l *lex) *UserAgent {
 	for _, f := range []parseFn{parseGecko, parseChromeSafari, parseIE1, parseIE2, parseOperaClassic} {
 		if ua := f(newLex(l.s)); ua != nil {
+			parseDevice(l, ua)
+			parseArch(l, ua)
 			return ua
 		}
 	}
@@ -73,9 +112,9 @@ func parseMozillaLike(l *lex, ua *UserAgent) bool {
 		ua.Security = parseSecurity(l)
 		ua.OS = OSAndroid
 		if l.match("; Mobile") {
-			ua.Mobile = true
+			ua.setDevice(DevicePhone)
 		} else if l.match("; Tablet") {
-			ua.Tablet = true
+			ua.setDevice(DeviceTablet)
 		}
 	case l.match("Linux; "):
 		ua.Security = parseSecurity(l)
@@ -97,19 +136,19 @@ func parseMozillaLike(l *lex, ua *UserAgent) bool {
 	case l.match("Mobile; "):
 		ua.Security = parseSecurity(l)
 		ua.OS = "Firefox OS"
-		ua.Mobile = true
+		ua.setDevice(DevicePhone)
 	case l.match("Tablet; "):
 		ua.Security = parseSecurity(l)
 		ua.OS = "Firefox OS"
-		ua.Tablet = true
+		ua.setDevice(DeviceTablet)
 	case l.match("iPad; "):
 		ua.Security = parseSecurity(l)
 		ua.OS = OSiOS
-		ua.Tablet = true
+		ua.setDevice(DeviceTablet)
 	case l.match("iPhone; ") || l.match("iPod; ") || l.match("iPod touch; "):
 		ua.Security = parseSecurity(l)
 		ua.OS = OSiOS
-		ua.Mobile = true
+		ua.setDevice(DevicePhone)
 	case l.match("Unknown; "):
 		ua.Security = parseSecurity(l)
 		parseUnixLike(l, ua)
@@ -147,15 +186,114 @@ func parseUnixLike(l *lex, ua *UserAgent) bool {
 		ua.OS = "CrOS"
 	default:
 		// Various distros use "... Distro; Linux x86_64) "
-		if _, ok := l.spanBefore("Linux", ") "); ok {
-			ua.OS = OSLinux
-			return true
+		if _, ok := l.spanBefore("Linux", ") "); !ok {
+			return false
 		}
-		return false
+		ua.OS = OSLinux
+	}
+	if ua.OS == OSLinux {
+		parseDistribution(l, ua)
 	}
 	return true
 }
 
+// Distribution names as found in UAs, more specific first.
+var distributions = []struct {
+	token string
+	name  string
+}{
+	{"Kubuntu", "Kubuntu"},
+	{"Xubuntu", "Xubuntu"},
+	{"Ubuntu", "Ubuntu"},
+	{"Linux Mint", "Linux Mint"},
+	// a bare "Mint" is too common a word
+	{"Mint/", "Linux Mint"},
+	{"Fedora", "Fedora"},
+	{"Red Hat", "Red Hat"},
+	{"CentOS", "CentOS"},
+	{"Debian", "Debian"},
+	{"Raspbian", "Raspbian"},
+	{"openSUSE", "openSUSE"},
+	{"SUSE", "SUSE"},
+	{"Arch Linux", "Arch Linux"},
+	{"Manjaro", "Manjaro"},
+	{"Gentoo", "Gentoo"},
+}
+
+// Find the distribution anywhere in the UA string, either in the comment
+// (Ubuntu; Linux x86_64) or as a product (Fedora/38)
+func parseDistribution(l *lex, ua *UserAgent) {
+	for _, d := range distributions {
+		dl := newLex(l.s)
+		if _, ok := dl.spanToken(d.token); !ok {
+			continue
+		}
+		ua.Distribution = d.name
+		if strings.HasSuffix(d.token, "/") || dl.match("/") {
+			i := strings.IndexAny(dl.s[dl.p:], " ;)")
+			if i < 0 {
+				i = len(dl.s) - dl.p
+			}
+			ua.DistributionVersion = dl.s[dl.p : dl.p+i]
+		}
+		return
+	}
+}
+
+// CPU architecture tokens and their bitness. Architecture names follow Sec-CH-UA-Arch.
+// "Intel Mac OS X" is also sent by Apple silicon Macs so it's ignored, use ParseHints.
+var archTokens = map[string]struct {
+	arch    string
+	bitness int
+}{
+	"AMD64":   {"x86", 64},
+	"ARM64":   {"arm", 64},
+	"PPC":     {"ppc", 32},
+	"WOW64":   {"x86", 64},
+	"Win64":   {"x86", 64},
+	"aarch64": {"arm", 64},
+	"amd64":   {"x86", 64},
+	"arm64":   {"arm", 64},
+	"armv6l":  {"arm", 32},
+	"armv7l":  {"arm", 32},
+	"armv8l":  {"arm", 32},
+	"i386":    {"x86", 32},
+	"i586":    {"x86", 32},
+	"i686":    {"x86", 32},
+	"mips":    {"mips", 32},
+	"mips64":  {"mips", 64},
+	"ppc":     {"ppc", 32},
+	"ppc64":   {"ppc", 64},
+	"ppc64le": {"ppc", 64},
+	"sparc64": {"sparc", 64},
+	"x64":     {"x86", 64},
+	"x86":     {"x86", 32},
+	"x86_64":  {"x86", 64},
+}
+
+// Look for the architecture in the first comment.
+// The frozen platforms of reduced UAs don't tell the real CPU, use ParseHints.
+func parseArch(l *lex, ua *UserAgent) {
+	if ua.Reduced && reducedPlatformRegexp.MatchString(l.s) {
+		return
+	}
+	cl := newLex(l.s)
+	if _, ok := cl.span("("); !ok {
+		return
+	}
+	comment, ok := cl.span(")")
+	if !ok {
+		return
+	}
+	for _, token := range strings.FieldsFunc(comment, func(r rune) bool { return r == ';' || r == ' ' }) {
+		if a, ok := archTokens[token]; ok {
+			ua.Arch = a.arch
+			ua.Bitness = a.bitness
+			return
+		}
+	}
+}
+
 // https://developer.mozilla.org/en-US/docs/Web/HTTP/Gecko_user_agent_string_reference
 func parseGecko(l *lex) *UserAgent {
 	ua := new()
@@ -166,17 +304,35 @@ func parseGecko(l *lex) *UserAgent {
 	if !l.match("Gecko/") {
 		return nil
 	}
+	ua.Engine = EngineGecko
+	ua.EngineVersion, _ = findVersion(l, rvRegexp)
 	if _, ok := l.span(" "); !ok {
 		return nil
 	}
 	if !parseNameVersion(l, ua) {
 		return nil
 	}
+	// Pale Moon's Gecko fork
+	if ua.Name == "Goanna" {
+		ua.Engine = EngineGoanna
+		ua.EngineVersion = ua.Version
+		if !parseNameVersion(l, ua) {
+			return nil
+		}
+		if _, ok := l.span("PaleMoon/"); ok {
+			if !parseVersion(l, ua, " ") {
+				return nil
+			}
+			ua.Name = "Pale Moon"
+		}
+	}
 	if _, ok := l.span("Opera "); ok {
 		if !parseVersion(l, ua, " ") {
 			return nil
 		}
 		ua.Name = "Opera"
+		ua.Engine = EnginePresto
+		ua.EngineVersion = semver.Version{}
 	}
 
 	return ua
@@ -192,16 +348,25 @@ func parseChromeSafari(l *lex) *UserAgent {
 	if !l.match("AppleWebKit/") {
 		return nil
 	}
-	if _, ok := l.span(" "); !ok {
+	s, ok := l.span(" ")
+	if !ok {
 		return nil
 	}
+	ua.Engine = EngineWebKit
+	// some versions aren't numeric (e.g. 537.13+)
+	ua.EngineVersion, _ = lexVersion(newLex(s), " ")
 	if !l.match("(KHTML, like Gecko) ") {
 		return nil
 	}
-	if !parseNameVersion(l, ua) {
+	start := l.p
+	if newLex(l.s[start:]).match("Mobile/") {
+		// iOS apps' web views don't add a browser token, e.g.:
+		//	... AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 [FBAN/FBIOS;FBDV/iPhone15,2;...]
+		ua.Name = "WebView"
+		ua.Type = Library
+	} else if !parseNameVersion(l, ua) {
 		return nil
-	}
-	if ua.Name == "CriOS" {
+	} else if ua.Name == "CriOS" {
 		ua.Name = "Chrome"
 	} else if ua.Name == "FxiOS" {
 		ua.Name = "Firefox"
@@ -218,7 +383,8 @@ func parseChromeSafari(l *lex) *UserAgent {
 					return nil
 				}
 			}
-			if !l.match("Safari/") {
+			// derivatives may put their token before Safari's
+			if _, ok := l.span("Safari/"); !ok {
 				return nil
 			}
 			ua.Name = "Safari"
@@ -233,30 +399,95 @@ func parseChromeSafari(l *lex) *UserAgent {
 		}
 	}
 
-	if l.match("Mobile") && !ua.Tablet {
-		ua.Mobile = true
+	// derivatives may put their token before Chrome's
+	if l.match("Chrome/") {
+		if _, ok := l.span(" "); !ok {
+			return nil
+		}
+	}
+
+	// look from start, the spans above skip derivatives' tokens and Mobile
+	// may be among them (e.g. Version/4.0 UCBrowser/13.4.0.1306 Mobile Safari/537.36)
+	if strings.Contains(l.s[start:], "Mobile") && !ua.Tablet {
+		ua.setDevice(DevicePhone)
 	}
 	if ua.OS == OSAndroid && !ua.Mobile {
-		ua.Tablet = true
+		ua.setDevice(DeviceTablet)
 	}
 
 	// Identify non-Chrome browsers with Chromelike UAs:
-	if _, ok := l.span("OPR/"); ok {
-		if !parseVersion(l, ua, " ") {
-			return nil
+	for _, d := range chromeDerivatives {
+		dl := &lex{l.s, start}
+		if _, ok := dl.span(d.token); ok {
+			if !parseVersion(dl, ua, " ") {
+				return nil
+			}
+			ua.Name = d.name
+			ua.Type = Browser
+			break
 		}
-		ua.Name = "Opera"
 	}
-	if _, ok := l.span("Edge/"); ok {
-		if !parseVersion(l, ua, " ") {
-			return nil
+
+	// Blink was forked from WebKit in Chrome 28, on iOS everything is WebKit
+	if ua.OS != OSiOS {
+		if v, ok := findVersion(l, chromeRegexp); ok && v.Major >= 28 {
+			ua.Engine = EngineBlink
+			ua.EngineVersion = v
+		}
+		if v, ok := findVersion(l, edgeHTMLRegexp); ok {
+			ua.Engine = EngineEdgeHTML
+			ua.EngineVersion = v
 		}
-		ua.Name = "Edge"
 	}
 
+	parseReduced(l, ua)
+
 	return ua
 }
 
+// Chromium's UA reduction freezes the version to MAJOR.0.0.0 and the
+// platform to a few fixed strings: https://www.chromium.org/updates/ua-reduction/
+var reducedVersionRegexp = regexp.MustCompile(`(?:\b|Headless)Chrome/\d+\.0\.0\.0\b`)
+var reducedPlatformRegexp = regexp.MustCompile(`^Mozilla/5\.0 \((?:Linux; Android 10; K|Macintosh; Intel Mac OS X 10_15_7|Windows NT 10\.0; Win64; x64|X11; Linux x86_64)\)`)
+
+// Mark reduced UAs and forget the placeholder OS versions
+func parseReduced(l *lex, ua *UserAgent) {
+	if !reducedVersionRegexp.MatchString(l.s) {
+		return
+	}
+	ua.Reduced = true
+	if reducedPlatformRegexp.MatchString(l.s) {
+		ua.OSVersion = semver.Version{}
+	}
+}
+
+// Browsers based on Chrome (or Safari on iOS) adding their own product token.
+// The first matching token wins, so more specific tokens must come first.
+// Remember to add the name to browsers.
+var chromeDerivatives = []struct {
+	token string
+	name  string
+}{
+	// DuckDuckGo on Windows also sends Edg/
+	{"Ddg/", "DuckDuckGo"},
+	{"DuckDuckGo/", "DuckDuckGo"},
+	{"SamsungBrowser/", "Samsung Internet"},
+	{"UCBrowser/", "UC Browser"},
+	{"YaBrowser/", "Yandex Browser"},
+	{"Vivaldi/", "Vivaldi"},
+	{"Whale/", "Whale"},
+	{"QQBrowser/", "QQ Browser"},
+	{"MiuiBrowser/", "MIUI Browser"},
+	{"HuaweiBrowser/", "Huawei Browser"},
+	{"OPR/", "Opera"},
+	// EdgeHTML
+	{"Edge/", "Edge"},
+	// Chromium-based Edge (version 79 and later)
+	{"Edg/", "Edge"},
+	{"EdgA/", "Edge"},
+	{"EdgiOS/", "Edge"},
+}
+
 // pre IE11 uas
 func parseIE1(l *lex) *UserAgent {
 	ua := new()
@@ -286,11 +517,16 @@ func parseIE1(l *lex) *UserAgent {
 	// swallow the error to preserve backwards compatibility
 	_ = parseOSVersion(l, ua)
 
+	ua.Engine = EngineTrident
+	ua.EngineVersion, _ = findVersion(l, tridentRegexp)
+
 	if _, ok := l.span("Opera "); ok {
 		if !parseVersion(l, ua, " ") {
 			return nil
 		}
 		ua.Name = "Opera"
+		ua.Engine = EnginePresto
+		ua.EngineVersion = semver.Version{}
 	}
 
 	return ua
@@ -315,6 +551,8 @@ func parseIE2(l *lex) *UserAgent {
 	if _, ok := l.span("Trident/"); !ok {
 		return nil
 	}
+	ua.Engine = EngineTrident
+	ua.EngineVersion, _ = findVersion(l, tridentRegexp)
 	if _, ok := l.span("rv:"); !ok {
 		return nil
 	}
@@ -370,7 +608,8 @@ func parseOperaClassic(l *lex) *UserAgent {
 		}
 	}
 	if l.match(" Presto/") {
-		l.span(" ")
+		ua.Engine = EnginePresto
+		ua.EngineVersion, _ = lexVersion(l, " ")
 	}
 	if l.match("Version/") && !parseVersion(l, ua, " ") {
 		return nil
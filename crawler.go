@@ -3,28 +3,196 @@
 package useragent
 
 import (
-	"net/url"
+	"regexp"
 )
 
 // Keep them sorted
-var crawlers = map[string]*url.URL{
-	"Google AdsBot":    u("https://support.google.com/webmasters/answer/1061943"),
-	"Google AdSense":   u("https://support.google.com/webmasters/answer/1061943"),
-	"Googlebot":        u("http://www.google.com/bot.html"),
-	"Googlebot Images": u("https://support.google.com/webmasters/answer/1061943"),
-	"Googlebot News":   u("https://support.google.com/news/publisher/answer/93977"),
-	"Googlebot Video":  u("https://support.google.com/webmasters/answer/1061943"),
+var crawlers = map[string]CrawlerInfo{
+	"APIs-Google":               {URL: u("https://developers.google.com/search/docs/crawling-indexing/google-special-case-crawlers"), Operator: "Google", RobotsToken: "APIs-Google", RespectsRobots: true},
+	"AhrefsBot":                 {URL: u("https://ahrefs.com/robot"), Operator: "Ahrefs", Purpose: PurposeSEO, RobotsToken: "AhrefsBot", RespectsRobots: true},
+	"Amazonbot":                 {URL: u("https://developer.amazon.com/amazonbot"), Operator: "Amazon", Purpose: PurposeTraining, RobotsToken: "Amazonbot", RespectsRobots: true},
+	"Applebot":                  {URL: u("https://support.apple.com/en-us/119829"), Operator: "Apple", Purpose: PurposeSearch, RobotsToken: "Applebot", RendersJS: true, RespectsRobots: true},
+	"Applebot-Extended":         {URL: u("https://support.apple.com/en-us/119829"), Operator: "Apple", Purpose: PurposeTraining, RobotsToken: "Applebot-Extended", RespectsRobots: true},
+	"BLEXBot":                   {URL: u("http://webmeup-crawler.com/"), Operator: "WebMeUp", Purpose: PurposeSEO, RobotsToken: "BLEXBot", RespectsRobots: true},
+	"Baiduspider":               {URL: u("https://www.baidu.com/search/spider.html"), Operator: "Baidu", Purpose: PurposeSearch, RobotsToken: "Baiduspider", RespectsRobots: true},
+	"BingPreview":               {URL: u("https://www.bing.com/webmasters/help/which-crawlers-does-bing-use-8c184ec0"), Operator: "Microsoft", Purpose: PurposePreview, RobotsToken: "BingPreview", RendersJS: true, RespectsRobots: true},
+	"Bingbot":                   {URL: u("https://www.bing.com/webmasters/help/which-crawlers-does-bing-use-8c184ec0"), Operator: "Microsoft", Purpose: PurposeSearch, RobotsToken: "bingbot", RendersJS: true, RespectsRobots: true},
+	"Bytespider":                {URL: u("https://www.bytedance.com/"), Operator: "ByteDance", Purpose: PurposeTraining, RobotsToken: "Bytespider"},
+	"CCBot":                     {URL: u("https://commoncrawl.org/ccbot"), Operator: "Common Crawl", Purpose: PurposeTraining, RobotsToken: "CCBot", RespectsRobots: true},
+	"ChatGPT-User":              {URL: u("https://platform.openai.com/docs/bots"), Operator: "OpenAI", Purpose: PurposeUserFetch, RobotsToken: "ChatGPT-User"},
+	"Claude-SearchBot":          {URL: u("https://support.anthropic.com/en/articles/8896518"), Operator: "Anthropic", Purpose: PurposeSearch, RobotsToken: "Claude-SearchBot", RespectsRobots: true},
+	"Claude-User":               {URL: u("https://support.anthropic.com/en/articles/8896518"), Operator: "Anthropic", Purpose: PurposeUserFetch, RobotsToken: "Claude-User", RespectsRobots: true},
+	"ClaudeBot":                 {URL: u("https://support.anthropic.com/en/articles/8896518"), Operator: "Anthropic", Purpose: PurposeTraining, RobotsToken: "ClaudeBot", RespectsRobots: true},
+	"DataForSeoBot":             {URL: u("https://dataforseo.com/dataforseo-bot"), Operator: "DataForSEO", Purpose: PurposeSEO, RobotsToken: "DataForSeoBot", RespectsRobots: true},
+	"Diffbot":                   {URL: u("https://www.diffbot.com/"), Operator: "Diffbot", Purpose: PurposeTraining, RobotsToken: "Diffbot", RendersJS: true, RespectsRobots: true},
+	"DotBot":                    {URL: u("https://moz.com/help/moz-procedures/crawlers/dotbot"), Operator: "Moz", Purpose: PurposeSEO, RobotsToken: "dotbot", RespectsRobots: true},
+	"DuckDuckBot":               {URL: u("https://duckduckgo.com/duckduckbot"), Operator: "DuckDuckGo", Purpose: PurposeSearch, RobotsToken: "DuckDuckBot", RespectsRobots: true},
+	"Exabot":                    {URL: u("https://www.exalead.com/search/webmasterguide"), Operator: "Exalead", Purpose: PurposeSearch, RobotsToken: "Exabot", RespectsRobots: true},
+	"GPTBot":                    {URL: u("https://platform.openai.com/docs/bots"), Operator: "OpenAI", Purpose: PurposeTraining, RobotsToken: "GPTBot", RespectsRobots: true},
+	"Google AdSense":            {URL: u("https://support.google.com/webmasters/answer/1061943"), Operator: "Google", Purpose: PurposeAds, RobotsToken: "Mediapartners-Google", RendersJS: true, RespectsRobots: true},
+	"Google AdsBot":             {URL: u("https://support.google.com/webmasters/answer/1061943"), Operator: "Google", Purpose: PurposeAds, RobotsToken: "AdsBot-Google", RendersJS: true, RespectsRobots: true},
+	"Google AdsBot Mobile":      {URL: u("https://developers.google.com/search/docs/crawling-indexing/google-special-case-crawlers"), Operator: "Google", Purpose: PurposeAds, RobotsToken: "AdsBot-Google-Mobile", RendersJS: true, RespectsRobots: true},
+	"Google Duplex":             {URL: u("https://developers.google.com/search/docs/crawling-indexing/google-special-case-crawlers"), Operator: "Google", Purpose: PurposeUserFetch, RobotsToken: "DuplexWeb-Google", RendersJS: true, RespectsRobots: true},
+	"Google Favicon":            {URL: u("https://developers.google.com/search/docs/crawling-indexing/google-user-triggered-fetchers"), Operator: "Google", Purpose: PurposeUserFetch},
+	"Google Read Aloud":         {URL: u("https://developers.google.com/search/docs/crawling-indexing/google-user-triggered-fetchers"), Operator: "Google", Purpose: PurposeUserFetch, RendersJS: true},
+	"Google Safety":             {URL: u("https://developers.google.com/search/docs/crawling-indexing/google-special-case-crawlers"), Operator: "Google"},
+	"Google Site Verifier":      {URL: u("https://developers.google.com/search/docs/crawling-indexing/google-user-triggered-fetchers"), Operator: "Google", Purpose: PurposeUserFetch},
+	"Google Storebot":           {URL: u("https://developers.google.com/search/docs/crawling-indexing/google-common-crawlers"), Operator: "Google", Purpose: PurposeSearch, RobotsToken: "Storebot-Google", RendersJS: true, RespectsRobots: true},
+	"Google-CloudVertexBot":     {URL: u("https://developers.google.com/search/docs/crawling-indexing/google-common-crawlers"), Operator: "Google", Purpose: PurposeSearch, RobotsToken: "Google-CloudVertexBot", RespectsRobots: true},
+	"GoogleOther":               {URL: u("https://developers.google.com/search/docs/crawling-indexing/google-common-crawlers"), Operator: "Google", RobotsToken: "GoogleOther", RespectsRobots: true},
+	"GoogleOther Images":        {URL: u("https://developers.google.com/search/docs/crawling-indexing/google-common-crawlers"), Operator: "Google", RobotsToken: "GoogleOther-Image", RespectsRobots: true},
+	"GoogleOther Video":         {URL: u("https://developers.google.com/search/docs/crawling-indexing/google-common-crawlers"), Operator: "Google", RobotsToken: "GoogleOther-Video", RespectsRobots: true},
+	"Googlebot":                 {URL: u("http://www.google.com/bot.html"), Operator: "Google", Purpose: PurposeSearch, RobotsToken: "Googlebot", RendersJS: true, RespectsRobots: true},
+	"Googlebot Images":          {URL: u("https://support.google.com/webmasters/answer/1061943"), Operator: "Google", Purpose: PurposeSearch, RobotsToken: "Googlebot-Image", RespectsRobots: true},
+	"Googlebot News":            {URL: u("https://support.google.com/news/publisher/answer/93977"), Operator: "Google", Purpose: PurposeSearch, RobotsToken: "Googlebot-News", RespectsRobots: true},
+	"Googlebot Video":           {URL: u("https://support.google.com/webmasters/answer/1061943"), Operator: "Google", Purpose: PurposeSearch, RobotsToken: "Googlebot-Video", RespectsRobots: true},
+	"MJ12bot":                   {URL: u("https://mj12bot.com/"), Operator: "Majestic", Purpose: PurposeSEO, RobotsToken: "MJ12bot", RespectsRobots: true},
+	"MSNBot":                    {URL: u("https://www.bing.com/webmasters/help/which-crawlers-does-bing-use-8c184ec0"), Operator: "Microsoft", Purpose: PurposeSearch, RobotsToken: "msnbot", RespectsRobots: true},
+	"Meta-ExternalAgent":        {URL: u("https://developers.facebook.com/docs/sharing/webmasters/web-crawlers"), Operator: "Meta", Purpose: PurposeTraining, RobotsToken: "meta-externalagent", RespectsRobots: true},
+	"Meta-ExternalFetcher":      {URL: u("https://developers.facebook.com/docs/sharing/webmasters/web-crawlers"), Operator: "Meta", Purpose: PurposeUserFetch, RobotsToken: "meta-externalfetcher"},
+	"OAI-SearchBot":             {URL: u("https://platform.openai.com/docs/bots"), Operator: "OpenAI", Purpose: PurposeSearch, RobotsToken: "OAI-SearchBot", RespectsRobots: true},
+	"Perplexity-User":           {URL: u("https://docs.perplexity.ai/guides/bots"), Operator: "Perplexity", Purpose: PurposeUserFetch, RobotsToken: "Perplexity-User"},
+	"PerplexityBot":             {URL: u("https://docs.perplexity.ai/guides/bots"), Operator: "Perplexity", Purpose: PurposeSearch, RobotsToken: "PerplexityBot", RespectsRobots: true},
+	"PetalBot":                  {URL: u("https://webmaster.petalsearch.com/site/petalbot"), Operator: "Huawei", Purpose: PurposeSearch, RobotsToken: "PetalBot", RespectsRobots: true},
+	"Qwantbot":                  {URL: u("https://help.qwant.com/bot/"), Operator: "Qwant", Purpose: PurposeSearch, RobotsToken: "Qwantbot", RespectsRobots: true},
+	"Screaming Frog SEO Spider": {URL: u("https://www.screamingfrog.co.uk/seo-spider/"), Operator: "Screaming Frog", Purpose: PurposeSEO, RobotsToken: "Screaming Frog SEO Spider", RendersJS: true, RespectsRobots: true},
+	"SemrushBot":                {URL: u("https://www.semrush.com/bot/"), Operator: "Semrush", Purpose: PurposeSEO, RobotsToken: "SemrushBot", RespectsRobots: true},
+	"SeznamBot":                 {URL: u("https://o-seznam.cz/napoveda/vyhledavani/en/seznambot-crawler/"), Operator: "Seznam.cz", Purpose: PurposeSearch, RobotsToken: "SeznamBot", RespectsRobots: true},
+	"SiteAuditBot":              {URL: u("https://www.semrush.com/bot/"), Operator: "Semrush", Purpose: PurposeSEO, RobotsToken: "SiteAuditBot", RendersJS: true, RespectsRobots: true},
+	"Sogou web spider":          {URL: u("http://www.sogou.com/docs/help/webmasters.htm#07"), Operator: "Sogou", Purpose: PurposeSearch, RobotsToken: "Sogou", RespectsRobots: true},
+	"Yahoo! Slurp":              {URL: u("https://help.yahoo.com/kb/SLN22600.html"), Operator: "Yahoo!", Purpose: PurposeSearch, RobotsToken: "Slurp", RespectsRobots: true},
+	"YandexAccessibilityBot":    {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposeMonitor},
+	"YandexAdditional":          {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposeSearch, RobotsToken: "YandexAdditional", RespectsRobots: true},
+	"YandexAdditionalBot":       {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposeSearch, RobotsToken: "YandexAdditionalBot", RespectsRobots: true},
+	"YandexBlogs":               {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposeSearch, RobotsToken: "YandexBlogs", RespectsRobots: true},
+	"YandexBot":                 {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposeSearch, RobotsToken: "YandexBot", RendersJS: true, RespectsRobots: true},
+	"YandexCalendar":            {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposeUserFetch},
+	"YandexCatalog":             {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposeSearch, RobotsToken: "YandexCatalog", RespectsRobots: true},
+	"YandexDirect":              {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposeAds, RobotsToken: "YandexDirect", RespectsRobots: true},
+	"YandexDirectDyn":           {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposeAds, RobotsToken: "YandexDirectDyn", RespectsRobots: true},
+	"YandexFavicons":            {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposeSearch, RobotsToken: "YandexFavicons", RespectsRobots: true},
+	"YandexImages":              {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposeSearch, RobotsToken: "YandexImages", RespectsRobots: true},
+	"YandexMarket":              {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposeSearch, RobotsToken: "YandexMarket", RespectsRobots: true},
+	"YandexMedia":               {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposeSearch, RobotsToken: "YandexMedia", RespectsRobots: true},
+	"YandexMetrika":             {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex"},
+	"YandexMobileBot":           {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposeSearch, RobotsToken: "YandexMobileBot", RendersJS: true, RespectsRobots: true},
+	"YandexMobileScreenShotBot": {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposePreview, RobotsToken: "YandexMobileScreenShotBot", RendersJS: true, RespectsRobots: true},
+	"YandexNews":                {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposeSearch, RobotsToken: "YandexNews", RespectsRobots: true},
+	"YandexRenderResourcesBot":  {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposeSearch, RobotsToken: "YandexRenderResourcesBot", RendersJS: true, RespectsRobots: true},
+	"YandexScreenshot":          {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposePreview, RobotsToken: "YandexScreenshot", RendersJS: true, RespectsRobots: true},
+	"YandexScreenshotBot":       {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposePreview, RobotsToken: "YandexScreenshotBot", RendersJS: true, RespectsRobots: true},
+	"YandexSitelinks":           {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposeSearch, RobotsToken: "YandexSitelinks", RespectsRobots: true},
+	"YandexSpravBot":            {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposeSearch, RobotsToken: "YandexSpravBot", RespectsRobots: true},
+	"YandexSpravbot":            {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposeSearch, RobotsToken: "YandexSpravbot", RespectsRobots: true},
+	"YandexTracker":             {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposeUserFetch, RobotsToken: "YandexTracker", RespectsRobots: true},
+	"YandexVerticals":           {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposeSearch, RobotsToken: "YandexVerticals", RespectsRobots: true},
+	"YandexVideo":               {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposeSearch, RobotsToken: "YandexVideo", RespectsRobots: true},
+	"YandexWebmaster":           {URL: u("https://yandex.com/support/webmaster/robot-workings/check-yandex-robots.html"), Operator: "Yandex", Purpose: PurposeUserFetch, RobotsToken: "YandexWebmaster", RespectsRobots: true},
+	"Yeti":                      {URL: u("https://naver.me/spd"), Operator: "Naver", Purpose: PurposeSearch, RobotsToken: "Yeti", RespectsRobots: true},
+	"anthropic-ai":              {URL: u("https://support.anthropic.com/en/articles/8896518"), Operator: "Anthropic", Purpose: PurposeTraining, RobotsToken: "anthropic-ai", RespectsRobots: true},
+	"archive.org_bot":           {URL: u("https://archive.org/details/archive.org_bot"), Operator: "Internet Archive", Purpose: PurposeArchive, RobotsToken: "archive.org_bot"},
+	"coccocbot":                 {URL: u("https://help.coccoc.com/searchengine"), Operator: "Cốc Cốc", Purpose: PurposeSearch, RobotsToken: "coccocbot", RespectsRobots: true},
+	"cohere-ai":                 {URL: u("https://cohere.com/"), Operator: "Cohere", Purpose: PurposeTraining, RobotsToken: "cohere-ai"},
+	"rogerbot":                  {URL: u("https://moz.com/help/moz-procedures/crawlers/rogerbot"), Operator: "Moz", Purpose: PurposeSEO, RobotsToken: "rogerbot", RespectsRobots: true},
+	"serpstatbot":               {URL: u("https://serpstatbot.com/"), Operator: "Serpstat", Purpose: PurposeSEO, RobotsToken: "serpstatbot", RespectsRobots: true},
 }
 
 func parseCrawler(l *lex) *UserAgent {
-	for _, f := range []parseFn{parseGooglebot, parseGooglebotSmartphone} {
+	for _, f := range []parseFn{parseGooglebot, parseGooglebotSmartphone, parseAICrawler, parseYandex, parseSearchCrawler, parseSEOCrawler, parseArchiveCrawler} {
 		if ua := f(newLex(l.s)); ua != nil {
+			setCrawlerInfo(ua)
 			return ua
 		}
 	}
 	return nil
 }
 
+// AI crawlers and fetchers, more specific tokens first
+var aiCrawlerProducts = []product{
+	{"GPTBot", "GPTBot"},
+	{"ChatGPT-User", "ChatGPT-User"},
+	{"OAI-SearchBot", "OAI-SearchBot"},
+	{"ClaudeBot", "ClaudeBot"},
+	{"Claude-User", "Claude-User"},
+	{"Claude-SearchBot", "Claude-SearchBot"},
+	{"anthropic-ai", "anthropic-ai"},
+	{"PerplexityBot", "PerplexityBot"},
+	{"Perplexity-User", "Perplexity-User"},
+	{"CCBot", "CCBot"},
+	{"Bytespider", "Bytespider"},
+	{"Amazonbot", "Amazonbot"},
+	{"Applebot-Extended", "Applebot-Extended"},
+	{"meta-externalagent", "Meta-ExternalAgent"},
+	{"Meta-ExternalAgent", "Meta-ExternalAgent"},
+	{"meta-externalfetcher", "Meta-ExternalFetcher"},
+	{"Meta-ExternalFetcher", "Meta-ExternalFetcher"},
+	{"cohere-ai", "cohere-ai"},
+	{"Diffbot", "Diffbot"},
+	{"Google-CloudVertexBot", "Google-CloudVertexBot"},
+}
+
+func parseAICrawler(l *lex) *UserAgent {
+	return parseProduct(l, Crawler, aiCrawlerProducts, nil)
+}
+
+// Smartphone crawlers embed a full mobile browser UA, e.g.:
+//
+//	Mozilla/5.0 (iPhone; ...) ... Mobile Safari/537.36 (compatible; bingbot/2.0; +http://www.bing.com/bingbot.htm)
+func parseCrawlerDevice(l *lex, ua *UserAgent) {
+	if b := parseBrowser(newLex(l.s)); b != nil && b.Mobile {
+		ua.setDevice(b.Device)
+	}
+}
+
+// Search engine crawlers, more specific tokens first
+var searchCrawlerProducts = []product{
+	{"bingbot", "Bingbot"},
+	{"BingPreview", "BingPreview"},
+	{"msnbot", "MSNBot"},
+	{"DuckDuckBot", "DuckDuckBot"},
+	{"Baiduspider", "Baiduspider"},
+	{"Applebot", "Applebot"},
+	{"Yahoo! Slurp", "Yahoo! Slurp"},
+	{"Sogou web spider", "Sogou web spider"},
+	{"SeznamBot", "SeznamBot"},
+	{"Yeti", "Yeti"},
+	{"Qwantbot", "Qwantbot"},
+	{"PetalBot", "PetalBot"},
+	{"Exabot", "Exabot"},
+	{"coccocbot", "coccocbot"},
+}
+
+func parseSearchCrawler(l *lex) *UserAgent {
+	ua := parseProduct(l, Crawler, searchCrawlerProducts, nil)
+	if ua == nil {
+		return nil
+	}
+	parseCrawlerDevice(l, ua)
+	return ua
+}
+
+// Yandex has many robots: YandexBot, YandexImages, YandexMobileBot, YandexMetrika, ...
+var yandexRegexp = regexp.MustCompile(`\b(Yandex(?:[A-Z][A-Za-z]*)?Bot|Yandex(?:Images|Video|Media|Metrika|Direct|DirectDyn|Favicons|Blogs|News|Market|Webmaster|Screenshot|Verticals|Tracker|Calendar|Additional|Catalog|Sitelinks|Spravbot))\b`)
+
+func parseYandex(l *lex) *UserAgent {
+	_, name, ok := l.spanRegexp(yandexRegexp)
+	if !ok {
+		return nil
+	}
+	ua := new()
+	ua.Type = Crawler
+	ua.Name = name
+	// for robots missing in crawlers
+	ua.URL = crawlers["YandexBot"].URL
+	ua.Operator = "Yandex"
+	if l.match("/") {
+		// versions aren't required
+		ua.Version, _ = lexProductVersion(l)
+	}
+	parseCrawlerDevice(l, ua)
+	return ua
+}
+
 func parseGooglebot(l *lex) *UserAgent {
 	ua := new()
 	ua.Type = Crawler
@@ -46,30 +214,52 @@ func parseGooglebot(l *lex) *UserAgent {
 			return nil
 		}
 		return ua
-	} else if l.match("Mediapartners-Google") {
-		ua.Name = "Google AdSense"
-		return ua
-	} else if l.match("AdsBot-Google") {
-		ua.Name = "Google AdsBot"
-		return ua
 	}
 
-	// Googlebot
-	if !l.match("Mozilla/5.0 (compatible; Googlebot/") {
-		return nil
+	// Googlebot, the second form is the current desktop one
+	if l.match("Mozilla/5.0 (compatible; Googlebot/") || l.match("Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko; compatible; Googlebot/") {
+		ua.Name = "Googlebot"
+		if parseVersion(l, ua, ";") && l.match(" +http://www.google.com/bot.html)") {
+			return ua
+		}
 	}
 
-	ua.Name = "Googlebot"
-
-	if !parseVersion(l, ua, ";") {
+	// Other Google crawlers and fetchers, often appended to a browser UA
+	ua = parseProduct(newLex(l.s), Crawler, googleProducts, nil)
+	if ua == nil {
 		return nil
 	}
-	if !l.match(" +http://www.google.com/bot.html)") {
-		return nil
+	parseCrawlerDevice(l, ua)
+	if ua.Name == "Google AdsBot Mobile" && !ua.Mobile {
+		ua.setDevice(DevicePhone)
 	}
 	return ua
 }
 
+// Google crawlers and fetchers, more specific tokens first. See
+// https://developers.google.com/search/docs/crawling-indexing/overview-google-crawlers
+// FeedFetcher-Google is a feed reader, see feedreader.go, and
+// Google-InspectionTool (Rich Results Test, URL Inspection) a validator, see validator.go.
+var googleProducts = []product{
+	{"Googlebot-Image", "Googlebot Images"},
+	{"Googlebot-News", "Googlebot News"},
+	{"Googlebot-Video", "Googlebot Video"},
+	{"Mediapartners-Google", "Google AdSense"},
+	{"AdsBot-Google-Mobile-Apps", "Google AdsBot"},
+	{"AdsBot-Google-Mobile", "Google AdsBot Mobile"},
+	{"AdsBot-Google", "Google AdsBot"},
+	{"Storebot-Google", "Google Storebot"},
+	{"GoogleOther-Image", "GoogleOther Images"},
+	{"GoogleOther-Video", "GoogleOther Video"},
+	{"GoogleOther", "GoogleOther"},
+	{"APIs-Google", "APIs-Google"},
+	{"Google-Read-Aloud", "Google Read Aloud"},
+	{"Google Favicon", "Google Favicon"},
+	{"Google-Site-Verification", "Google Site Verifier"},
+	{"Google-Safety", "Google Safety"},
+	{"DuplexWeb-Google", "Google Duplex"},
+}
+
 func parseGooglebotSmartphone(l *lex) *UserAgent {
 	ua := new()
 
@@ -106,6 +296,33 @@ func parseGooglebotSmartphone(l *lex) *UserAgent {
 	}
 	ua.Type = Crawler
 	ua.Name = "Googlebot"
-	ua.Mobile = true
+	ua.setDevice(DevicePhone)
 	return ua
 }
+
+// SEO and marketing crawlers, more specific tokens first
+var seoCrawlerProducts = []product{
+	{"AhrefsSiteAudit", "AhrefsBot"},
+	{"AhrefsBot", "AhrefsBot"},
+	{"SiteAuditBot", "SiteAuditBot"},
+	{"SemrushBot", "SemrushBot"},
+	{"MJ12bot", "MJ12bot"},
+	{"DotBot", "DotBot"},
+	{"rogerbot", "rogerbot"},
+	{"BLEXBot", "BLEXBot"},
+	{"DataForSeoBot", "DataForSeoBot"},
+	{"serpstatbot", "serpstatbot"},
+	{"Screaming Frog SEO Spider", "Screaming Frog SEO Spider"},
+}
+
+func parseSEOCrawler(l *lex) *UserAgent {
+	return parseProduct(l, Crawler, seoCrawlerProducts, nil)
+}
+
+var archiveCrawlerProducts = []product{
+	{"archive.org_bot", "archive.org_bot"},
+}
+
+func parseArchiveCrawler(l *lex) *UserAgent {
+	return parseProduct(l, Crawler, archiveCrawlerProducts, nil)
+}
@@ -0,0 +1,63 @@
+// Written by https://xojoc.pw. GPLv3 or later.
+
+package useragent
+
+import (
+	"net/url"
+)
+
+// Information about a crawler (or previewer, or monitor, or validator, or scanner).
+type CrawlerInfo struct {
+	// URL with more information about the crawler. If unknown is nil.
+	URL *url.URL
+	// Company or project running the crawler, or the vendor of a scanner.
+	Operator string
+	Purpose  Purpose
+	// The product token the crawler looks for in robots.txt (e.g. Googlebot-Image).
+	// If unknown (or robots.txt is ignored) is empty.
+	RobotsToken string
+	// Does it execute JavaScript?
+	RendersJS bool
+	// Is it documented as respecting robots.txt?
+	RespectsRobots bool
+}
+
+// The registry for agents of type t, by name
+func crawlerInfos(t Type) map[string]CrawlerInfo {
+	switch t {
+	case Crawler:
+		return crawlers
+	case Previewer:
+		return previewers
+	case Monitor:
+		return monitors
+	case Validator:
+		return validators
+	case Scanner:
+		return scanners
+	default:
+		return nil
+	}
+}
+
+// Information about the crawler, previewer, monitor, validator or scanner ua is.
+// Returns false if ua is another type of agent or isn't a known crawler.
+func (ua *UserAgent) Crawler() (CrawlerInfo, bool) {
+	info, ok := crawlerInfos(ua.Type)[ua.Name]
+	return info, ok
+}
+
+// Fill URL, Operator and Purpose from the crawler registry
+func setCrawlerInfo(ua *UserAgent) {
+	info, ok := ua.Crawler()
+	if !ok {
+		return
+	}
+	if info.URL != nil {
+		ua.URL = info.URL
+	}
+	if ua.Operator == "" {
+		ua.Operator = info.Operator
+	}
+	ua.Purpose = info.Purpose
+}
@@ -0,0 +1,228 @@
+// Written by https://xojoc.pw. GPLv3 or later.
+
+package useragent
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Devices which can't be told apart by the OS alone.
+// The first matching marker wins.
+var deviceMarkers = []struct {
+	marker string
+	device DeviceType
+}{
+	{"OculusBrowser", DeviceXR},
+	{"PicoBrowser", DeviceXR},
+	{"PlayStation", DeviceConsole},
+	{"Xbox", DeviceConsole},
+	{"Nintendo", DeviceConsole},
+	{"SMART-TV", DeviceTV},
+	{"SmartTV", DeviceTV},
+	{"HbbTV", DeviceTV},
+	{"Web0S", DeviceTV},
+	{"CrKey", DeviceTV},
+	{"GoogleTV", DeviceTV},
+	{"Android TV", DeviceTV},
+	{"BRAVIA", DeviceTV},
+	{"; AFT", DeviceTV},
+	{"Tesla/", DeviceCar},
+	{"Kindle/", DeviceEReader},
+	{"Kobo", DeviceEReader},
+	{"KAIOS", DeviceFeaturePhone},
+	{"KaiOS", DeviceFeaturePhone},
+	{"Series40", DeviceFeaturePhone},
+	{" Watch", DeviceWearable},
+	{"Windows Phone", DevicePhone},
+}
+
+func parseDevice(l *lex, ua *UserAgent) {
+	parseDeviceType(l, ua)
+	parseDeviceModel(l, ua)
+}
+
+// Refine the device type with deviceMarkers, otherwise assume
+// desktop OSs are running on a desktop.
+func parseDeviceType(l *lex, ua *UserAgent) {
+	for _, d := range deviceMarkers {
+		if strings.Contains(l.s, d.marker) {
+			ua.setDevice(d.device)
+			return
+		}
+	}
+	if ua.Device == DeviceUnknown {
+		switch ua.OS {
+		case OSWindows, OSMacOS, OSLinux, "FreeBSD", "OpenBSD", "NetBSD", "CrOS":
+			ua.setDevice(DeviceDesktop)
+		}
+	}
+}
+
+// Apple's hardware identifiers, sent by some iOS apps
+var appleModelRegexp = regexp.MustCompile(`\b((?:iPhone|iPad|iPod|Watch)\d+,\d+)\b`)
+var appleDeviceRegexp = regexp.MustCompile(`^Mozilla/5\.0 \((iPhone|iPad|iPod)`)
+
+// Language tags and other noise found in the Android comment
+var androidSkipRegexp = regexp.MustCompile(`^(?:[UIN]|wv|K|Mobile|Tablet|rv:.*|[a-z]{2}(?:[-_][a-zA-Z]{2,4})?)$`)
+
+func parseDeviceModel(l *lex, ua *UserAgent) {
+	switch ua.OS {
+	case OSAndroid:
+		if model := androidModel(l.s); model != "" {
+			setDeviceModel(ua, model)
+		}
+	case OSiOS:
+		if _, model, ok := newLex(l.s).spanRegexp(appleModelRegexp); ok {
+			setDeviceModel(ua, model)
+		} else if _, model, ok := newLex(l.s).spanRegexp(appleDeviceRegexp); ok {
+			ua.DeviceVendor = "Apple"
+			ua.DeviceModel = model
+		}
+	}
+}
+
+// Find the model in the comment, e.g.:
+//
+//	Mozilla/5.0 (Linux; Android 14; SM-S918B Build/UP1A.231005.007) ...
+//
+// The "K" placeholder of reduced UAs is ignored.
+func androidModel(s string) string {
+	l := newLex(s)
+	if _, ok := l.span("Android"); !ok {
+		return ""
+	}
+	comment, ok := l.span(")")
+	if !ok {
+		return ""
+	}
+	fields := strings.Split(comment, ";")
+	// the first field is the Android version
+	for _, f := range fields[1:] {
+		f = strings.TrimSpace(f)
+		if i := strings.Index(f, "Build/"); i >= 0 {
+			f = strings.TrimSpace(f[:i])
+		}
+		if f == "" || androidSkipRegexp.MatchString(f) {
+			continue
+		}
+		return f
+	}
+	return ""
+}
+
+// Set vendor and model, using the marketing name if known
+func setDeviceModel(ua *UserAgent, model string) {
+	model = strings.TrimPrefix(model, "SAMSUNG ")
+	if d, ok := lookupDevice(model); ok {
+		ua.DeviceVendor = d.vendor
+		ua.DeviceModel = d.name
+		return
+	}
+	ua.DeviceModel = model
+	for _, v := range deviceVendors {
+		if strings.HasPrefix(model, v.prefix) {
+			ua.DeviceVendor = v.vendor
+			return
+		}
+	}
+}
+
+// Samsung appends a region letter to its models (SM-S918B, SM-S918U, ...)
+func lookupDevice(model string) (device, bool) {
+	if d, ok := devices[model]; ok {
+		return d, true
+	}
+	if strings.HasPrefix(model, "SM-") && len(model) > 7 {
+		d, ok := devices[model[:7]]
+		return d, ok
+	}
+	return device{}, false
+}
+
+type device struct {
+	vendor string
+	name   string
+}
+
+// Raw model codes to marketing names. Keep them sorted.
+var devices = map[string]device{
+	"Galaxy Nexus": {"Samsung", "Galaxy Nexus"},
+	"KFTHWI":       {"Amazon", "Kindle Fire HDX 7"},
+	"M2101K6G":     {"Xiaomi", "Redmi Note 10 Pro"},
+	"Nexus 5":      {"Google", "Nexus 5"},
+	"Nexus 5X":     {"Google", "Nexus 5X"},
+	"Pixel 6":      {"Google", "Pixel 6"},
+	"Pixel 6 Pro":  {"Google", "Pixel 6 Pro"},
+	"Pixel 6a":     {"Google", "Pixel 6a"},
+	"Pixel 7":      {"Google", "Pixel 7"},
+	"Pixel 7 Pro":  {"Google", "Pixel 7 Pro"},
+	"Pixel 7a":     {"Google", "Pixel 7a"},
+	"Pixel 8":      {"Google", "Pixel 8"},
+	"Pixel 8 Pro":  {"Google", "Pixel 8 Pro"},
+	"RMX1911":      {"Realme", "Realme 5"},
+	"SM-A536":      {"Samsung", "Galaxy A53 5G"},
+	"SM-A546":      {"Samsung", "Galaxy A54 5G"},
+	"SM-G930":      {"Samsung", "Galaxy S7"},
+	"SM-G973":      {"Samsung", "Galaxy S10"},
+	"SM-G991":      {"Samsung", "Galaxy S21"},
+	"SM-G996":      {"Samsung", "Galaxy S21+"},
+	"SM-G998":      {"Samsung", "Galaxy S21 Ultra"},
+	"SM-S901":      {"Samsung", "Galaxy S22"},
+	"SM-S906":      {"Samsung", "Galaxy S22+"},
+	"SM-S908":      {"Samsung", "Galaxy S22 Ultra"},
+	"SM-S911":      {"Samsung", "Galaxy S23"},
+	"SM-S916":      {"Samsung", "Galaxy S23+"},
+	"SM-S918":      {"Samsung", "Galaxy S23 Ultra"},
+	"SM-S921":      {"Samsung", "Galaxy S24"},
+	"SM-S926":      {"Samsung", "Galaxy S24+"},
+	"SM-S928":      {"Samsung", "Galaxy S24 Ultra"},
+	"SM-T350":      {"Samsung", "Galaxy Tab A 8.0"},
+	"iPhone12,1":   {"Apple", "iPhone 11"},
+	"iPhone12,3":   {"Apple", "iPhone 11 Pro"},
+	"iPhone12,5":   {"Apple", "iPhone 11 Pro Max"},
+	"iPhone12,8":   {"Apple", "iPhone SE (2nd generation)"},
+	"iPhone13,1":   {"Apple", "iPhone 12 mini"},
+	"iPhone13,2":   {"Apple", "iPhone 12"},
+	"iPhone13,3":   {"Apple", "iPhone 12 Pro"},
+	"iPhone13,4":   {"Apple", "iPhone 12 Pro Max"},
+	"iPhone14,2":   {"Apple", "iPhone 13 Pro"},
+	"iPhone14,3":   {"Apple", "iPhone 13 Pro Max"},
+	"iPhone14,4":   {"Apple", "iPhone 13 mini"},
+	"iPhone14,5":   {"Apple", "iPhone 13"},
+	"iPhone14,6":   {"Apple", "iPhone SE (3rd generation)"},
+	"iPhone14,7":   {"Apple", "iPhone 14"},
+	"iPhone14,8":   {"Apple", "iPhone 14 Plus"},
+	"iPhone15,2":   {"Apple", "iPhone 14 Pro"},
+	"iPhone15,3":   {"Apple", "iPhone 14 Pro Max"},
+	"iPhone15,4":   {"Apple", "iPhone 15"},
+	"iPhone15,5":   {"Apple", "iPhone 15 Plus"},
+	"iPhone16,1":   {"Apple", "iPhone 15 Pro"},
+	"iPhone16,2":   {"Apple", "iPhone 15 Pro Max"},
+}
+
+// Vendors of models not in devices, by model prefix
+var deviceVendors = []struct {
+	prefix string
+	vendor string
+}{
+	{"SM-", "Samsung"},
+	{"GT-", "Samsung"},
+	{"Galaxy", "Samsung"},
+	{"Pixel", "Google"},
+	{"Nexus", "Google"},
+	{"KF", "Amazon"},
+	{"Redmi", "Xiaomi"},
+	{"Mi ", "Xiaomi"},
+	{"RMX", "Realme"},
+	{"CPH", "OPPO"},
+	{"moto", "Motorola"},
+	{"LM-", "LG"},
+	{"HUAWEI", "Huawei"},
+	{"ONEPLUS", "OnePlus"},
+	{"BRAVIA", "Sony"},
+	{"iPhone", "Apple"},
+	{"iPad", "Apple"},
+	{"iPod", "Apple"},
+	{"Watch", "Apple"},
+}
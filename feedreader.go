@@ -0,0 +1,50 @@
+// Written by https://xojoc.pw. GPLv3 or later.
+
+package useragent
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+)
+
+// Keep them sorted
+var feedReaders = map[string]*url.URL{
+	"Feedbin":            u("https://feedbin.com/"),
+	"Feedly":             u("https://feedly.com/"),
+	"FreshRSS":           u("https://freshrss.org/"),
+	"Google Feedfetcher": u("https://developers.google.com/search/docs/crawling-indexing/feedfetcher"),
+	"Inoreader":          u("https://www.inoreader.com/"),
+	"Miniflux":           u("https://miniflux.app/"),
+	"NewsBlur":           u("https://www.newsblur.com/"),
+	"The Old Reader":     u("https://theoldreader.com/"),
+	"Tiny Tiny RSS":      u("https://tt-rss.org/"),
+}
+
+var feedReaderProducts = []product{
+	{"Feedly", "Feedly"},
+	{"inoreader.com", "Inoreader"},
+	{"Inoreader", "Inoreader"},
+	{"NewsBlur", "NewsBlur"},
+	{"Feedbin", "Feedbin"},
+	{"theoldreader.com", "The Old Reader"},
+	{"Tiny Tiny RSS", "Tiny Tiny RSS"},
+	{"FreshRSS", "FreshRSS"},
+	{"Miniflux", "Miniflux"},
+	{"Feedfetcher-Google", "Google Feedfetcher"},
+	{"FeedFetcher-Google", "Google Feedfetcher"},
+}
+
+// e.g. "Feedly/1.0 (+http://www.feedly.com/fetcher.html; 1234 subscribers)"
+var subscribersRegexp = regexp.MustCompile(`\b(\d+) [Ss]ubscribers?\b`)
+
+func parseFeedReader(l *lex) *UserAgent {
+	ua := parseProduct(l, FeedReader, feedReaderProducts, feedReaders)
+	if ua == nil {
+		return nil
+	}
+	if _, s, ok := newLex(l.s).spanRegexp(subscribersRegexp); ok {
+		ua.Subscribers, _ = strconv.Atoi(s)
+	}
+	return ua
+}
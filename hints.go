@@ -0,0 +1,277 @@
+// Written by https://xojoc.pw. GPLv3 or later.
+
+package useragent
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/blang/semver"
+)
+
+// User-Agent Client Hints: https://wicg.github.io/ua-client-hints/
+// Values are RFC 8941 structured headers.
+
+// A structured header item with its parameters.
+type sfItem struct {
+	value  string
+	params map[string]string
+}
+
+// Parse an RFC 8941 list. Items that can't be parsed are skipped,
+// inner lists aren't supported since no client hint uses them.
+func parseSFList(s string) []sfItem {
+	var items []sfItem
+	l := newLex(s)
+	for {
+		skipOWS(l)
+		if l.p >= len(l.s) {
+			return items
+		}
+		item, ok := parseSFItem(l)
+		if ok {
+			items = append(items, item)
+		}
+		// skip to the next member, if any
+		if _, ok := l.span(","); !ok {
+			return items
+		}
+	}
+}
+
+func skipOWS(l *lex) {
+	for l.p < len(l.s) && (l.s[l.p] == ' ' || l.s[l.p] == '\t') {
+		l.p++
+	}
+}
+
+func parseSFItem(l *lex) (sfItem, bool) {
+	var item sfItem
+	v, ok := parseSFBareItem(l)
+	if !ok {
+		return item, false
+	}
+	item.value = v
+	for l.match(";") {
+		skipOWS(l)
+		k := parseSFToken(l)
+		if k == "" {
+			return item, false
+		}
+		v := "1"
+		if l.match("=") {
+			if v, ok = parseSFBareItem(l); !ok {
+				return item, false
+			}
+		}
+		if item.params == nil {
+			item.params = map[string]string{}
+		}
+		item.params[k] = v
+	}
+	return item, true
+}
+
+// Strings are unquoted, booleans are returned as "1" or "0",
+// everything else (tokens, numbers) verbatim.
+func parseSFBareItem(l *lex) (string, bool) {
+	switch {
+	case l.match(`"`):
+		var b strings.Builder
+		for l.p < len(l.s) {
+			c := l.s[l.p]
+			l.p++
+			switch c {
+			case '\\':
+				if l.p >= len(l.s) {
+					return "", false
+				}
+				b.WriteByte(l.s[l.p])
+				l.p++
+			case '"':
+				return b.String(), true
+			default:
+				b.WriteByte(c)
+			}
+		}
+		return "", false
+	case l.match("?1"):
+		return "1", true
+	case l.match("?0"):
+		return "0", true
+	default:
+		s := parseSFToken(l)
+		return s, s != ""
+	}
+}
+
+func parseSFToken(l *lex) string {
+	i := strings.IndexAny(l.s[l.p:], ";,= \t")
+	if i < 0 {
+		i = len(l.s) - l.p
+	}
+	s := l.s[l.p : l.p+i]
+	l.p += i
+	return s
+}
+
+// Parse a single structured header item (eg Sec-CH-UA-Platform)
+func parseSFString(s string) (string, bool) {
+	l := newLex(strings.TrimSpace(s))
+	item, ok := parseSFItem(l)
+	return item.value, ok
+}
+
+// Brands are GREASEd with made up names like "Not A(Brand" or "Not?A_Brand"
+var greaseBrandRegexp = regexp.MustCompile(`(?i)^\s*not.a.brand\s*$`)
+
+// Map brand names to the names used by the UA string parsers
+var hintBrands = map[string]string{
+	"Android WebView": "WebView",
+	"Google Chrome":   "Chrome",
+	"Microsoft Edge":  "Edge",
+	"Opera":           "Opera",
+	"YaBrowser":       "Yandex Browser",
+}
+
+// Map Sec-CH-UA-Platform values to OS names
+var hintPlatforms = map[string]string{
+	"Android":   OSAndroid,
+	"Chrome OS": "CrOS",
+	"Linux":     OSLinux,
+	"Windows":   OSWindows,
+	"iOS":       OSiOS,
+	"macOS":     OSMacOS,
+}
+
+// Pick the most specific brand: GREASE is ignored and "Chromium"
+// is used only if nothing else is available.
+func pickBrand(items []sfItem) (name, version string, ok bool) {
+	for _, item := range items {
+		if greaseBrandRegexp.MatchString(item.value) {
+			continue
+		}
+		if item.value == "Chromium" && ok {
+			continue
+		}
+		name, version, ok = item.value, item.params["v"], true
+		if item.value != "Chromium" {
+			break
+		}
+	}
+	if n, found := hintBrands[name]; found {
+		name = n
+	}
+	return
+}
+
+// Brand versions may be only the major version (eg "120").
+// ua.Version is left alone if v isn't a version.
+func parseHintVersion(v string, ua *UserAgent) bool {
+	if v == "" {
+		return false
+	}
+	version, ok := lexVersion(newLex(v), " ")
+	if ok {
+		ua.Version = version
+	}
+	return ok
+}
+
+// Like Parse but uses the User-Agent Client Hints in h (Sec-CH-UA,
+// Sec-CH-UA-Full-Version-List, Sec-CH-UA-Platform, Sec-CH-UA-Platform-Version,
+// Sec-CH-UA-Mobile, Sec-CH-UA-Model, Sec-CH-UA-Arch, Sec-CH-UA-Bitness) to override the information found in uas,
+// since Chromium based browsers freeze most of their user agent string.
+// Brands (Sec-CH-UA, Sec-CH-UA-Full-Version-List) are only used for browsers.
+//
+// Note that on Windows Sec-CH-UA-Platform-Version is not the NT version:
+// 0.1, 0.2 and 0.3 are Windows 7, 8 and 8.1, 1 to 10 is Windows 10 and 13 and above is Windows 11.
+func ParseHints(uas string, h http.Header) *UserAgent {
+	ua := Parse(uas)
+
+	brands := h.Get("Sec-CH-UA")
+	fullVersions := h.Get("Sec-CH-UA-Full-Version-List")
+	platform := h.Get("Sec-CH-UA-Platform")
+	platformVersion := h.Get("Sec-CH-UA-Platform-Version")
+	mobile := h.Get("Sec-CH-UA-Mobile")
+	model := h.Get("Sec-CH-UA-Model")
+	arch := h.Get("Sec-CH-UA-Arch")
+	bitness := h.Get("Sec-CH-UA-Bitness")
+
+	if brands == "" && fullVersions == "" && platform == "" && platformVersion == "" && mobile == "" && model == "" && arch == "" && bitness == "" {
+		return ua
+	}
+	// Brands are only trusted for browsers: Chromium based crawlers (e.g. Storebot-Google)
+	// and WebViews send them too
+	brandsOK := ua == nil || ua.Type == Browser
+	if ua == nil {
+		ua = new()
+		ua.Type = Browser
+		ua.Original = uas
+	}
+
+	if brandsOK && fullVersions != "" {
+		if name, v, ok := pickBrand(parseSFList(fullVersions)); ok {
+			ua.Name = name
+			parseHintVersion(v, ua)
+		}
+	} else if brandsOK && brands != "" {
+		if name, v, ok := pickBrand(parseSFList(brands)); ok {
+			old := ua.Version
+			changed := name != ua.Name
+			ua.Name = name
+			// Only the major version is sent, don't lose precision if it matches
+			if parseHintVersion(v, ua) && !changed && ua.Version.Major == old.Major {
+				ua.Version = old
+			}
+		}
+	}
+
+	if p, ok := parseSFString(platform); ok && p != "" {
+		if os, found := hintPlatforms[p]; found {
+			p = os
+		}
+		if p != ua.OS {
+			ua.OSVersion = semver.Version{}
+		}
+		ua.OS = p
+	}
+	if s, ok := parseSFString(platformVersion); ok && s != "" {
+		if v, err := semver.ParseTolerant(s); err == nil {
+			ua.OSVersion = v
+			ua.osVersionFromHints = true
+		}
+	}
+
+	if m, ok := parseSFString(mobile); ok {
+		if m == "1" {
+			ua.setDevice(DevicePhone)
+		} else if ua.Mobile {
+			ua.setDevice(DeviceUnknown)
+		}
+	}
+	if ua.Type == Browser {
+		parseDevice(newLex(uas), ua)
+	}
+
+	if m, ok := parseSFString(model); ok && m != "" {
+		ua.DeviceVendor = ""
+		setDeviceModel(ua, m)
+	}
+
+	if a, ok := parseSFString(arch); ok && a != "" {
+		if a != ua.Arch {
+			ua.Bitness = 0
+		}
+		ua.Arch = a
+	}
+	if b, ok := parseSFString(bitness); ok {
+		if n, err := strconv.Atoi(b); err == nil {
+			ua.Bitness = n
+		}
+	}
+
+	return ua
+}
@@ -0,0 +1,154 @@
+// Written by https://xojoc.pw. GPLv3 or later.
+
+package useragent
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/blang/semver"
+)
+
+func TestParseSFList(t *testing.T) {
+	items := parseSFList(`"Not_A Brand";v="8", "Chromium";v="120", "Google Chrome";v="120"`)
+	if len(items) != 3 {
+		t.Fatalf("expected 3 items, got %d", len(items))
+	}
+	if items[0].value != "Not_A Brand" || items[0].params["v"] != "8" {
+		t.Errorf("unexpected item %+v", items[0])
+	}
+	if items[2].value != "Google Chrome" || items[2].params["v"] != "120" {
+		t.Errorf("unexpected item %+v", items[2])
+	}
+
+	items = parseSFList(`"Not\"A\\Brand";v="99" ,"Opera";v="105"`)
+	if len(items) != 2 || items[0].value != `Not"A\Brand` || items[1].value != "Opera" {
+		t.Errorf("unexpected items %+v", items)
+	}
+
+	if s, ok := parseSFString(`?1`); !ok || s != "1" {
+		t.Errorf("expected boolean true, got %q", s)
+	}
+	if s, ok := parseSFString(` "Windows"`); !ok || s != "Windows" {
+		t.Errorf("expected Windows, got %q", s)
+	}
+}
+
+func TestParseHints(t *testing.T) {
+	var got *UserAgent
+	want := &UserAgent{}
+
+	h := http.Header{}
+	h.Set("Sec-CH-UA", `"Not_A Brand";v="8", "Chromium";v="120", "Microsoft Edge";v="120"`)
+	h.Set("Sec-CH-UA-Full-Version-List", `"Not_A Brand";v="8.0.0.0", "Chromium";v="120.0.6099.130", "Microsoft Edge";v="120.0.2210.91"`)
+	h.Set("Sec-CH-UA-Platform", `"Windows"`)
+	h.Set("Sec-CH-UA-Platform-Version", `"15.0.0"`)
+	h.Set("Sec-CH-UA-Mobile", `?0`)
+	h.Set("Sec-CH-UA-Arch", `"arm"`)
+	h.Set("Sec-CH-UA-Bitness", `"64"`)
+	got = ParseHints(`Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36`, h)
+	want.Type = Browser
+	want.OS = "Windows"
+	want.OSVersion = mustParse("15.0.0")
+	want.Name = "Edge"
+	want.Version = mustParse("120.0.2210")
+	want.Security = SecurityUnknown
+	want.Reduced = true
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+	if got.OSVersionName() != "Windows 11" {
+		t.Errorf("expected Windows 11, got %s", got.OSVersionName())
+	}
+	for v, name := range map[string]string{`"0.1.0"`: "Windows 7", `"0.3.0"`: "Windows 8.1", `"10.0.0"`: "Windows 10", `"15.0.0"`: "Windows 11"} {
+		h.Set("Sec-CH-UA-Platform-Version", v)
+		if got := ParseHints(`Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36`, h).OSVersionName(); got != name {
+			t.Errorf("%s: expected %s, got %s", v, name, got)
+		}
+	}
+	if got.Arch != "arm" || got.Bitness != 64 {
+		t.Errorf("expected arm 64, got %s %d", got.Arch, got.Bitness)
+	}
+
+	h = http.Header{}
+	h.Set("Sec-CH-UA", `"Chromium";v="120", "Google Chrome";v="120", "Not?A_Brand";v="99"`)
+	h.Set("Sec-CH-UA-Platform", `"Android"`)
+	h.Set("Sec-CH-UA-Platform-Version", `"14.0.0"`)
+	h.Set("Sec-CH-UA-Mobile", `?1`)
+	h.Set("Sec-CH-UA-Model", `"Pixel 8"`)
+	got = ParseHints(`Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36`, h)
+	want.Type = Browser
+	want.OS = "Android"
+	want.OSVersion = mustParse("14.0.0")
+	want.Name = "Chrome"
+	want.Version = mustParse("120.0.0")
+	want.Security = SecurityUnknown
+	want.Mobile = true
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+	if got.DeviceVendor != "Google" || got.DeviceModel != "Pixel 8" {
+		t.Errorf("expected Google Pixel 8, got %s %s", got.DeviceVendor, got.DeviceModel)
+	}
+
+	// Hints without a known UA string
+	h = http.Header{}
+	h.Set("Sec-CH-UA", `"Chromium";v="119"`)
+	h.Set("Sec-CH-UA-Platform", `"Linux"`)
+	got = ParseHints(``, h)
+	want.Type = Browser
+	want.OS = "GNU/Linux"
+	want.OSVersion = semver.Version{}
+	want.Name = "Chromium"
+	want.Version = mustParse("119.0.0")
+	want.Security = SecurityUnknown
+	want.Mobile = false
+	want.Reduced = false
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	// Apple silicon Macs send Intel Mac OS X too
+	h = http.Header{}
+	h.Set("Sec-CH-UA-Arch", `"arm"`)
+	got = ParseHints(`Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36`, h)
+	if got.Arch != "arm" || got.Bitness != 0 {
+		t.Errorf("expected arm, got %s %d", got.Arch, got.Bitness)
+	}
+
+	// Chromium based crawlers keep their name
+	h = http.Header{}
+	h.Set("Sec-CH-UA", `"Chromium";v="79", "Google Chrome";v="79"`)
+	got = ParseHints(`Mozilla/5.0 (X11; Linux x86_64; Storebot-Google/1.0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/79.0.3945.88 Safari/537.36`, h)
+	if got == nil || got.Type != Crawler || got.Name != "Google Storebot" || !got.Version.EQ(mustParse("1.0")) {
+		t.Errorf("expected Google Storebot 1.0, got %+v", got)
+	}
+
+	// and so do WebViews
+	h = http.Header{}
+	h.Set("Sec-CH-UA", `"Chromium";v="120", "Android WebView";v="120"`)
+	got = ParseHints(`Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/120.0.6099.144 Mobile Safari/537.36`, h)
+	if got == nil || got.Type != Library || got.Name != "WebView" || !got.Version.EQ(mustParse("120.0.6099")) {
+		t.Errorf("expected WebView 120.0.6099, got %+v", got)
+	}
+
+	// a malformed version doesn't overwrite the one in the UA
+	h = http.Header{}
+	h.Set("Sec-CH-UA-Full-Version-List", `"Chromium";v="x.y", "Google Chrome";v="x.y"`)
+	got = ParseHints(`Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/119.0.6045.105 Safari/537.36`, h)
+	if got == nil || got.Name != "Chrome" || !got.Version.EQ(mustParse("119.0.6045")) {
+		t.Errorf("expected Chrome 119.0.6045, got %+v", got)
+	}
+
+	// Without hints ParseHints is Parse
+	got = ParseHints(`Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/41.0.2227.0 Safari/537.36`, http.Header{})
+	want.Type = Browser
+	want.OS = "GNU/Linux"
+	want.OSVersion = semver.Version{}
+	want.Name = "Chrome"
+	want.Version = mustParse("41.0.2227")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+}
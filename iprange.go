@@ -0,0 +1,152 @@
+// Written by https://xojoc.pw. GPLv3 or later.
+
+package useragent
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/netip"
+	"os"
+	"sync"
+)
+
+// A binary trie of IP prefixes, one per address family.
+type prefixTrie struct {
+	v4, v6 trieNode
+}
+
+type trieNode struct {
+	child [2]*trieNode
+	// a prefix ends here
+	end bool
+}
+
+func (t *prefixTrie) root(a netip.Addr) *trieNode {
+	if a.Is4() {
+		return &t.v4
+	}
+	return &t.v6
+}
+
+func bit(b []byte, i int) int {
+	return int(b[i/8]>>(7-i%8)) & 1
+}
+
+func (t *prefixTrie) insert(p netip.Prefix) {
+	p = p.Masked()
+	n := t.root(p.Addr())
+	b := p.Addr().AsSlice()
+	for i := 0; i < p.Bits(); i++ {
+		c := bit(b, i)
+		if n.child[c] == nil {
+			n.child[c] = &trieNode{}
+		}
+		n = n.child[c]
+	}
+	n.end = true
+}
+
+func (t *prefixTrie) contains(a netip.Addr) bool {
+	n := t.root(a)
+	b := a.AsSlice()
+	for i := 0; n != nil; i++ {
+		if n.end {
+			return true
+		}
+		if i == len(b)*8 {
+			return false
+		}
+		n = n.child[bit(b, i)]
+	}
+	return false
+}
+
+// The format used by Google, Bing, Apple, OpenAI, ... e.g.
+//
+//	{"creationTime": "...", "prefixes": [{"ipv4Prefix": "66.249.64.0/27"}, {"ipv6Prefix": "2001:4860:4801:10::/64"}]}
+type ipRangesFile struct {
+	Prefixes []struct {
+		IPv4Prefix string `json:"ipv4Prefix"`
+		IPv6Prefix string `json:"ipv6Prefix"`
+	} `json:"prefixes"`
+}
+
+// IPRanges holds the IP ranges of crawlers, keyed by crawler name (e.g. "GPTBot")
+// or operator (e.g. "Google").
+// The zero value is ready to use and it's safe for concurrent use.
+type IPRanges struct {
+	mu sync.RWMutex
+	m  map[string]*prefixTrie
+}
+
+// Load reads a JSON list of crawler IP ranges, as published by Google
+// (googlebot.json, special-crawlers.json, user-triggered-fetchers.json),
+// Bing (bingbot.json), Apple (applebot.json), OpenAI (gptbot.json), ...
+// The ranges are added to those already loaded for key.
+func (r *IPRanges) Load(key string, rd io.Reader) error {
+	var f ipRangesFile
+	if err := json.NewDecoder(rd).Decode(&f); err != nil {
+		return err
+	}
+	var prefixes []netip.Prefix
+	for _, p := range f.Prefixes {
+		for _, s := range []string{p.IPv4Prefix, p.IPv6Prefix} {
+			if s == "" {
+				continue
+			}
+			prefix, err := netip.ParsePrefix(s)
+			if err != nil {
+				return err
+			}
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	if len(prefixes) == 0 {
+		return errors.New("useragent: no IP prefixes found")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.m == nil {
+		r.m = map[string]*prefixTrie{}
+	}
+	t, ok := r.m[key]
+	if !ok {
+		t = &prefixTrie{}
+		r.m[key] = t
+	}
+	for _, p := range prefixes {
+		t.insert(p)
+	}
+	return nil
+}
+
+// LoadFile is like Load but reads the file at path.
+func (r *IPRanges) LoadFile(key, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return r.Load(key, f)
+}
+
+// VerifyIP reports whether ip is in the ranges loaded
+// for the bot ua claims to be (looked up by name, then by operator).
+// Works for any type (crawlers, previewers, feed readers, ...).
+// Returns false if no ranges were loaded for it.
+func (r *IPRanges) VerifyIP(ua *UserAgent, ip netip.Addr) bool {
+	if ua == nil {
+		return false
+	}
+	ip = ip.Unmap()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, key := range []string{ua.Name, ua.Operator} {
+		if t, ok := r.m[key]; ok && key != "" {
+			return t.contains(ip)
+		}
+	}
+	return false
+}
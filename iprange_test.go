@@ -0,0 +1,90 @@
+// Written by https://xojoc.pw. GPLv3 or later.
+
+package useragent
+
+import (
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestVerifyIP(t *testing.T) {
+	var ranges IPRanges
+	googlebot := `{
+  "creationTime": "2024-01-01T00:00:00.000000",
+  "prefixes": [
+    {"ipv6Prefix": "2001:4860:4801:10::/64"},
+    {"ipv4Prefix": "66.249.64.0/27"},
+    {"ipv4Prefix": "66.249.66.0/27"}
+  ]
+}`
+	if err := ranges.Load("Google", strings.NewReader(googlebot)); err != nil {
+		t.Fatal(err)
+	}
+	// Google splits its ranges in several files
+	if err := ranges.Load("Google", strings.NewReader(`{"prefixes": [{"ipv4Prefix": "66.249.90.64/27"}]}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "gptbot.json")
+	if err := os.WriteFile(path, []byte(`{"prefixes": [{"ipv4Prefix": "20.171.206.0/24"}]}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ranges.LoadFile("GPTBot", path); err != nil {
+		t.Fatal(err)
+	}
+	if err := ranges.Load("Google Feedfetcher", strings.NewReader(`{"prefixes": [{"ipv4Prefix": "66.249.80.0/24"}]}`)); err != nil {
+		t.Fatal(err)
+	}
+	if err := ranges.Load("Meta", strings.NewReader(`{"prefixes": [{"ipv4Prefix": "69.63.176.0/20"}]}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	googlebotUA := Parse(`Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)`)
+	gptbotUA := Parse(`Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko; compatible; GPTBot/1.1; +https://openai.com/gptbot)`)
+	tests := []struct {
+		ua   *UserAgent
+		ip   string
+		want bool
+	}{
+		{googlebotUA, "66.249.64.1", true},
+		{googlebotUA, "66.249.66.31", true},
+		{googlebotUA, "66.249.66.32", false},
+		{googlebotUA, "66.249.90.65", true},
+		{googlebotUA, "::ffff:66.249.64.1", true},
+		{googlebotUA, "2001:4860:4801:10::1", true},
+		{googlebotUA, "2001:4860:4801:11::1", false},
+		{googlebotUA, "20.171.206.1", false},
+		{gptbotUA, "20.171.206.1", true},
+		{gptbotUA, "66.249.64.1", false},
+		// no ranges loaded for Bing
+		{Parse(`Mozilla/5.0 (compatible; bingbot/2.0; +http://www.bing.com/bingbot.htm)`), "157.55.39.1", false},
+		{Parse(`curl/8.4.0`), "66.249.64.1", false},
+		// not crawlers, but still found by name or operator
+		{Parse(`Mozilla/5.0 (compatible; Google-InspectionTool/1.0;)`), "66.249.64.1", true},
+		{Parse(`Mozilla/5.0 (compatible; Google-InspectionTool/1.0;)`), "20.171.206.1", false},
+		{Parse(`FeedFetcher-Google; (+http://www.google.com/feedfetcher.html)`), "66.249.80.1", true},
+		{Parse(`FeedFetcher-Google; (+http://www.google.com/feedfetcher.html)`), "66.249.64.1", false},
+		{Parse(`facebookexternalhit/1.1 (+http://www.facebook.com/externalhit_uatext.php)`), "69.63.176.1", true},
+		{Parse(`facebookexternalhit/1.1 (+http://www.facebook.com/externalhit_uatext.php)`), "66.249.64.1", false},
+	}
+	for _, test := range tests {
+		if got := ranges.VerifyIP(test.ua, netip.MustParseAddr(test.ip)); got != test.want {
+			t.Errorf("%s %s: expected %v, got %v", test.ua.Name, test.ip, test.want, got)
+		}
+	}
+
+	var other IPRanges
+	if other.VerifyIP(googlebotUA, netip.MustParseAddr("66.249.64.1")) {
+		t.Errorf("expected no ranges in a new IPRanges")
+	}
+
+	if err := ranges.Load("Bing", strings.NewReader(`{"prefixes": [{"ipv4Prefix": "157.55.39.0/33"}]}`)); err == nil {
+		t.Errorf("expected an error for an invalid prefix")
+	}
+	if err := ranges.Load("Bing", strings.NewReader(`{}`)); err == nil {
+		t.Errorf("expected an error for a file without prefixes")
+	}
+}
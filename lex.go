@@ -79,6 +79,32 @@ func (l *lex) spanBefore(m, stopAt string) (string, bool) {
 	return s, true
 }
 
+// Like span but m must be a whole product token: at the start of the string or after
+// a space or punctuation (e.g. "(compatible; Foo/1.0)") and not followed by a letter or digit,
+// so "Foo" doesn't match "[Foo/iOS]" or "FooBar"
+func (l *lex) spanToken(m string) (string, bool) {
+	for i := l.p; ; {
+		j := strings.Index(l.s[i:], m)
+		if j < 0 {
+			return "", false
+		}
+		j += i
+		end := j + len(m)
+		left := j == 0 || strings.IndexByte(" (;,+", l.s[j-1]) >= 0
+		right := end == len(l.s) || strings.HasSuffix(m, "/") || !isAlnum(l.s[end])
+		if left && right {
+			s := l.s[l.p:j]
+			l.p = end
+			return s, true
+		}
+		i = j + 1
+	}
+}
+
+func isAlnum(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
 // assumes the first group is the bit we want
 func (l *lex) spanRegexp(re *regexp.Regexp) (before string, match string, success bool) {
 	loc := re.FindStringSubmatchIndex(l.s[l.p:])
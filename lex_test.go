@@ -78,3 +78,18 @@ func TestSpans(t *testing.T) {
 	m, ok = testLex.span("麼")
 	testLex.assertLex(t, ok, true, m, "什", " Firefox/38.0")
 }
+
+func TestSpanToken(t *testing.T) {
+	testLex := newLex("Mozilla/5.0 (iPhone) Mobile/15E148 [Pinterest/iOS] (compatible; Pinterestbot/1.0; Pinterest/0.2)")
+
+	m, ok := testLex.spanToken("Pinterest")
+	testLex.assertLex(t, ok, true, m, "Mozilla/5.0 (iPhone) Mobile/15E148 [Pinterest/iOS] (compatible; Pinterestbot/1.0; ", "/0.2)")
+
+	testLex = newLex("Mozilla/5.0 (iPhone) Mobile/15E148 [Pinterest/iOS]")
+	m, ok = testLex.spanToken("Pinterest")
+	testLex.assertLex(t, ok, false, m, "", "Mozilla/5.0 (iPhone) Mobile/15E148 [Pinterest/iOS]")
+
+	testLex = newLex("Foobot/1.0")
+	m, ok = testLex.spanToken("Foobot/")
+	testLex.assertLex(t, ok, true, m, "", "1.0")
+}
@@ -0,0 +1,50 @@
+// Written by https://xojoc.pw. GPLv3 or later.
+
+package useragent
+
+import (
+	"net/url"
+)
+
+// Keep them sorted
+var libraries = map[string]*url.URL{
+	"Apache-HttpClient": u("https://hc.apache.org/"),
+	"Dart":              u("https://api.dart.dev/stable/dart-io/HttpClient-class.html"),
+	"Go-http-client":    u("https://pkg.go.dev/net/http"),
+	"GuzzleHttp":        u("https://docs.guzzlephp.org/"),
+	"Java":              u("https://openjdk.org/groups/net/httpclient/"),
+	"Python-urllib":     u("https://docs.python.org/3/library/urllib.request.html"),
+	"Wget":              u("https://www.gnu.org/software/wget/"),
+	"aiohttp":           u("https://docs.aiohttp.org/"),
+	"axios":             u("https://axios-http.com/"),
+	"curl":              u("https://curl.se/"),
+	"libwww-perl":       u("https://metacpan.org/dist/libwww-perl"),
+	"node-fetch":        u("https://github.com/node-fetch/node-fetch"),
+	"okhttp":            u("https://square.github.io/okhttp/"),
+	"python-httpx":      u("https://www.python-httpx.org/"),
+	"python-requests":   u("https://requests.readthedocs.io/"),
+}
+
+// Libraries are often wrapped by other libraries (e.g. "Python/3.11 aiohttp/3.9.1",
+// "Apache-HttpClient/4.5.13 (Java/17.0.2)"), so more specific tokens first.
+var libraryProducts = []product{
+	{"python-requests/", "python-requests"},
+	{"python-httpx/", "python-httpx"},
+	{"aiohttp/", "aiohttp"},
+	{"Python-urllib/", "Python-urllib"},
+	{"Apache-HttpClient/", "Apache-HttpClient"},
+	{"okhttp/", "okhttp"},
+	{"Java/", "Java"},
+	{"GuzzleHttp/", "GuzzleHttp"},
+	{"libwww-perl/", "libwww-perl"},
+	{"node-fetch/", "node-fetch"},
+	{"axios/", "axios"},
+	{"Go-http-client/", "Go-http-client"},
+	{"Dart/", "Dart"},
+	{"curl/", "curl"},
+	{"Wget/", "Wget"},
+}
+
+func parseLibrary(l *lex) *UserAgent {
+	return parseProduct(l, Library, libraryProducts, libraries)
+}
@@ -0,0 +1,33 @@
+// Written by https://xojoc.pw. GPLv3 or later.
+
+package useragent
+
+import (
+	"net/url"
+)
+
+// Keep them sorted. linkchecker-go has no home page.
+var linkCheckers = map[string]*url.URL{
+	"Dead Link Checker": u("https://www.deadlinkchecker.com/"),
+	"LinkChecker":       u("https://linkchecker.github.io/linkchecker/"),
+	"W3C Link Checker":  u("https://validator.w3.org/checklink"),
+	"Xenu Link Sleuth":  u("http://home.snafu.de/tilman/xenulink.html"),
+	"lychee":            u("https://github.com/lycheeverse/lychee"),
+	"muffet":            u("https://github.com/raviqqe/muffet"),
+}
+
+// More specific tokens first. Screaming Frog SEO Spider is an SEO crawler, see crawler.go.
+var linkCheckerProducts = []product{
+	{"W3C-checklink", "W3C Link Checker"},
+	{"linkchecker-go", "linkchecker-go"},
+	{"LinkChecker", "LinkChecker"},
+	{"Xenu Link Sleuth", "Xenu Link Sleuth"},
+	{"lychee", "lychee"},
+	{"muffet", "muffet"},
+	{"www.deadlinkchecker.com", "Dead Link Checker"},
+	{"deadlinkchecker", "Dead Link Checker"},
+}
+
+func parseLinkChecker(l *lex) *UserAgent {
+	return parseProduct(l, LinkChecker, linkCheckerProducts, linkCheckers)
+}
@@ -0,0 +1,44 @@
+// Written by https://xojoc.pw. GPLv3 or later.
+
+package useragent
+
+// Keep them sorted
+var monitors = map[string]CrawlerInfo{
+	"Better Uptime":           {URL: u("https://betterstack.com/uptime"), Operator: "Better Stack", Purpose: PurposeMonitor},
+	"Checkly":                 {URL: u("https://www.checklyhq.com/"), Operator: "Checkly", Purpose: PurposeMonitor, RendersJS: true},
+	"Datadog Synthetics":      {URL: u("https://docs.datadoghq.com/synthetics/"), Operator: "Datadog", Purpose: PurposeMonitor, RendersJS: true},
+	"Google Cloud Monitoring": {URL: u("https://cloud.google.com/monitoring"), Operator: "Google", Purpose: PurposeMonitor},
+	"New Relic Synthetics":    {URL: u("https://docs.newrelic.com/docs/synthetics/"), Operator: "New Relic", Purpose: PurposeMonitor, RendersJS: true},
+	"Pingdom":                 {URL: u("https://www.pingdom.com/"), Operator: "SolarWinds", Purpose: PurposeMonitor, RendersJS: true},
+	"Route 53 Health Checks":  {URL: u("https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover.html"), Operator: "Amazon", Purpose: PurposeMonitor},
+	"Site24x7":                {URL: u("https://www.site24x7.com/"), Operator: "Zoho", Purpose: PurposeMonitor, RendersJS: true},
+	"StatusCake":              {URL: u("https://www.statuscake.com/"), Operator: "StatusCake", Purpose: PurposeMonitor},
+	"UptimeRobot":             {URL: u("https://uptimerobot.com/"), Operator: "UptimeRobot", Purpose: PurposeMonitor},
+}
+
+// Uptime monitors and synthetic checks. Most append their token to a browser UA
+// (e.g. "Mozilla/5.0 ... Chrome/107.0.0.0 Safari/537.36 StatusCake").
+var monitorProducts = []product{
+	{"UptimeRobot", "UptimeRobot"},
+	{"Pingdom.com_bot", "Pingdom"},
+	{"PingdomPageSpeed", "Pingdom"},
+	{"PingdomTMS", "Pingdom"},
+	{"StatusCake", "StatusCake"},
+	{"Site24x7", "Site24x7"},
+	{"Better Uptime Bot", "Better Uptime"},
+	{"Datadog/Synthetics", "Datadog Synthetics"},
+	{"DatadogSynthetics", "Datadog Synthetics"},
+	{"NewRelicSynthetics", "New Relic Synthetics"},
+	{"Checkly", "Checkly"},
+	{"GoogleStackdriverMonitoring", "Google Cloud Monitoring"},
+	{"Amazon-Route53-Health-Check-Service", "Route 53 Health Checks"},
+}
+
+func parseMonitor(l *lex) *UserAgent {
+	ua := parseProduct(l, Monitor, monitorProducts, nil)
+	if ua == nil {
+		return nil
+	}
+	setCrawlerInfo(ua)
+	return ua
+}
@@ -0,0 +1,162 @@
+// Written by https://xojoc.pw. GPLv3 or later.
+
+package useragent
+
+import (
+	"strconv"
+
+	"github.com/blang/semver"
+)
+
+type osRelease struct {
+	os    string
+	major uint64
+	// -1 matches any minor version
+	minor int64
+	name  string
+	// Android API level
+	api int
+}
+
+// Marketing names of OS versions. Keep them sorted by OS and version.
+var osReleases = []osRelease{
+	{OSAndroid, 1, 5, "Android 1.5 Cupcake", 3},
+	{OSAndroid, 1, 6, "Android 1.6 Donut", 4},
+	{OSAndroid, 2, 0, "Android 2.0 Eclair", 5},
+	{OSAndroid, 2, 1, "Android 2.1 Eclair", 7},
+	{OSAndroid, 2, 2, "Android 2.2 Froyo", 8},
+	{OSAndroid, 2, 3, "Android 2.3 Gingerbread", 10},
+	{OSAndroid, 3, 0, "Android 3.0 Honeycomb", 11},
+	{OSAndroid, 3, 1, "Android 3.1 Honeycomb", 12},
+	{OSAndroid, 3, 2, "Android 3.2 Honeycomb", 13},
+	{OSAndroid, 4, 0, "Android 4.0 Ice Cream Sandwich", 15},
+	{OSAndroid, 4, 1, "Android 4.1 Jelly Bean", 16},
+	{OSAndroid, 4, 2, "Android 4.2 Jelly Bean", 17},
+	{OSAndroid, 4, 3, "Android 4.3 Jelly Bean", 18},
+	{OSAndroid, 4, 4, "Android 4.4 KitKat", 19},
+	{OSAndroid, 5, 0, "Android 5.0 Lollipop", 21},
+	{OSAndroid, 5, 1, "Android 5.1 Lollipop", 22},
+	{OSAndroid, 6, -1, "Android 6.0 Marshmallow", 23},
+	{OSAndroid, 7, 0, "Android 7.0 Nougat", 24},
+	{OSAndroid, 7, 1, "Android 7.1 Nougat", 25},
+	{OSAndroid, 8, 0, "Android 8.0 Oreo", 26},
+	{OSAndroid, 8, 1, "Android 8.1 Oreo", 27},
+	{OSAndroid, 9, -1, "Android 9 Pie", 28},
+	{OSAndroid, 10, -1, "Android 10 Quince Tart", 29},
+	{OSAndroid, 11, -1, "Android 11 Red Velvet Cake", 30},
+	{OSAndroid, 12, -1, "Android 12 Snow Cone", 31},
+	{OSAndroid, 13, -1, "Android 13 Tiramisu", 33},
+	{OSAndroid, 14, -1, "Android 14 Upside Down Cake", 34},
+	{OSAndroid, 15, -1, "Android 15 Vanilla Ice Cream", 35},
+	{OSAndroid, 16, -1, "Android 16 Baklava", 36},
+
+	{OSMacOS, 10, 0, "Mac OS X Cheetah", 0},
+	{OSMacOS, 10, 1, "Mac OS X Puma", 0},
+	{OSMacOS, 10, 2, "Mac OS X Jaguar", 0},
+	{OSMacOS, 10, 3, "Mac OS X Panther", 0},
+	{OSMacOS, 10, 4, "Mac OS X Tiger", 0},
+	{OSMacOS, 10, 5, "Mac OS X Leopard", 0},
+	{OSMacOS, 10, 6, "Mac OS X Snow Leopard", 0},
+	{OSMacOS, 10, 7, "OS X Lion", 0},
+	{OSMacOS, 10, 8, "OS X Mountain Lion", 0},
+	{OSMacOS, 10, 9, "OS X Mavericks", 0},
+	{OSMacOS, 10, 10, "OS X Yosemite", 0},
+	{OSMacOS, 10, 11, "OS X El Capitan", 0},
+	{OSMacOS, 10, 12, "macOS Sierra", 0},
+	{OSMacOS, 10, 13, "macOS High Sierra", 0},
+	{OSMacOS, 10, 14, "macOS Mojave", 0},
+	{OSMacOS, 10, 15, "macOS Catalina", 0},
+	{OSMacOS, 11, -1, "macOS Big Sur", 0},
+	{OSMacOS, 12, -1, "macOS Monterey", 0},
+	{OSMacOS, 13, -1, "macOS Ventura", 0},
+	{OSMacOS, 14, -1, "macOS Sonoma", 0},
+	{OSMacOS, 15, -1, "macOS Sequoia", 0},
+	{OSMacOS, 26, -1, "macOS Tahoe", 0},
+
+	// Windows NT versions. Windows 11 still reports NT 10.0,
+	// use ParseHints to tell them apart.
+	{OSWindows, 5, 0, "Windows 2000", 0},
+	{OSWindows, 5, 1, "Windows XP", 0},
+	{OSWindows, 5, 2, "Windows XP x64", 0},
+	{OSWindows, 6, 0, "Windows Vista", 0},
+	{OSWindows, 6, 1, "Windows 7", 0},
+	{OSWindows, 6, 2, "Windows 8", 0},
+	{OSWindows, 6, 3, "Windows 8.1", 0},
+	{OSWindows, 10, 0, "Windows 10/11", 0},
+}
+
+// Sec-CH-UA-Platform-Version on Windows, see
+// https://learn.microsoft.com/en-us/microsoft-edge/web-platform/how-to-detect-win11
+var windowsHintReleases = []osRelease{
+	{OSWindows, 0, 1, "Windows 7", 0},
+	{OSWindows, 0, 2, "Windows 8", 0},
+	{OSWindows, 0, 3, "Windows 8.1", 0},
+}
+
+// Safari and Chrome stopped updating the macOS version after 10.15.7,
+// use ParseHints to get the real one.
+var macOSFrozen = semver.Version{Major: 10, Minor: 15, Patch: 7}
+
+func findOSRelease(ua *UserAgent) (osRelease, bool) {
+	for _, r := range osReleases {
+		if r.os == ua.OS && r.major == ua.OSVersion.Major && (r.minor < 0 || uint64(r.minor) == ua.OSVersion.Minor) {
+			return r, true
+		}
+	}
+	return osRelease{}, false
+}
+
+// The marketing name of the OS version, for example:
+//
+//	Windows 7
+//	Windows 10/11 (they both send Windows NT 10.0, use ParseHints to tell them apart)
+//	macOS Sonoma
+//	macOS Catalina or later (Safari sends 10.15.7 on newer versions too)
+//	Android 4.4 KitKat
+//	iPadOS 17
+//
+// If unknown returns an empty string.
+func (ua *UserAgent) OSVersionName() string {
+	if ua.OSVersion.Major == 0 && ua.OSVersion.Minor == 0 {
+		return ""
+	}
+	switch {
+	case ua.OS == OSWindows && ua.osVersionFromHints:
+		// Sec-CH-UA-Platform-Version isn't the NT version
+		switch {
+		case ua.OSVersion.Major >= 13:
+			return "Windows 11"
+		case ua.OSVersion.Major >= 1 && ua.OSVersion.Major <= 10:
+			return "Windows 10"
+		}
+		for _, r := range windowsHintReleases {
+			if r.major == ua.OSVersion.Major && uint64(r.minor) == ua.OSVersion.Minor {
+				return r.name
+			}
+		}
+		return ""
+	case ua.OS == OSiOS:
+		// iPads run iPadOS since 13
+		if ua.Tablet && ua.OSVersion.Major >= 13 {
+			return "iPadOS " + strconv.FormatUint(ua.OSVersion.Major, 10)
+		}
+		return "iOS " + strconv.FormatUint(ua.OSVersion.Major, 10)
+	case ua.OS == OSMacOS && !ua.osVersionFromHints && ua.OSVersion.Equals(macOSFrozen):
+		return "macOS Catalina or later"
+	}
+	if r, ok := findOSRelease(ua); ok {
+		return r.name
+	}
+	return ""
+}
+
+// The Android API level (e.g. 34 for Android 14). If unknown returns 0.
+func (ua *UserAgent) AndroidAPILevel() int {
+	if ua.OS != OSAndroid {
+		return 0
+	}
+	if r, ok := findOSRelease(ua); ok {
+		return r.api
+	}
+	return 0
+}
@@ -22,6 +22,12 @@ const (
 	Validator
 	FeedReader
 	Library
+	// Fetches pages to render link previews (e.g. Open Graph cards)
+	Previewer
+	// Uptime monitors and synthetic checks
+	Monitor
+	// Security scanners and attack tools
+	Scanner
 )
 
 func (a Type) String() string {
@@ -40,6 +46,106 @@ func (a Type) String() string {
 		return "Feed Reader"
 	case Library:
 		return "Library"
+	case Previewer:
+		return "Link Previewer"
+	case Monitor:
+		return "Monitor"
+	case Scanner:
+		return "Scanner"
+	default:
+		panic("cannot happen")
+	}
+}
+
+// The kind of device the user agent is running on.
+type DeviceType int
+
+const (
+	DeviceUnknown DeviceType = iota
+	DeviceDesktop
+	DevicePhone
+	DeviceTablet
+	DeviceTV
+	DeviceConsole
+	DeviceWearable
+	DeviceCar
+	DeviceEReader
+	DeviceXR
+	DeviceFeaturePhone
+)
+
+func (d DeviceType) String() string {
+	switch d {
+	case DeviceUnknown:
+		return "Unknown device"
+	case DeviceDesktop:
+		return "Desktop"
+	case DevicePhone:
+		return "Phone"
+	case DeviceTablet:
+		return "Tablet"
+	case DeviceTV:
+		return "TV"
+	case DeviceConsole:
+		return "Console"
+	case DeviceWearable:
+		return "Wearable"
+	case DeviceCar:
+		return "Car"
+	case DeviceEReader:
+		return "E-reader"
+	case DeviceXR:
+		return "XR headset"
+	case DeviceFeaturePhone:
+		return "Feature phone"
+	default:
+		panic("cannot happen")
+	}
+}
+
+// Why a crawler (or previewer, or monitor) fetches pages.
+type Purpose int
+
+const (
+	PurposeUnknown Purpose = iota
+	// Crawls to collect AI training data
+	PurposeTraining
+	// Crawls to build a search index (including AI search)
+	PurposeSearch
+	// Fetches pages on behalf of a user (e.g. an AI assistant answering a question)
+	PurposeUserFetch
+	// Crawls for SEO and marketing tools (backlinks, site audits, ...)
+	PurposeSEO
+	// Checks ad landing pages
+	PurposeAds
+	// Crawls for a web archive
+	PurposeArchive
+	// Fetches pages to show link previews
+	PurposePreview
+	// Checks that sites are up
+	PurposeMonitor
+)
+
+func (p Purpose) String() string {
+	switch p {
+	case PurposeUnknown:
+		return "Unknown purpose"
+	case PurposeTraining:
+		return "Training"
+	case PurposeSearch:
+		return "Search"
+	case PurposeUserFetch:
+		return "User fetch"
+	case PurposeSEO:
+		return "SEO"
+	case PurposeAds:
+		return "Ads"
+	case PurposeArchive:
+		return "Archive"
+	case PurposePreview:
+		return "Preview"
+	case PurposeMonitor:
+		return "Monitor"
 	default:
 		panic("cannot happen")
 	}
@@ -84,6 +190,18 @@ type UserAgent struct {
 	// If the name is not known, Name will be `unknown'.
 	Name    string
 	Version semver.Version
+	// The rendering engine. Can be one of:
+	//  Gecko
+	//  Goanna
+	//  WebKit
+	//  Blink
+	//  Trident
+	//  EdgeHTML
+	//  Presto
+	//   etc.
+	// If the engine is not known, Engine will be `unknown'.
+	Engine        string
+	EngineVersion semver.Version
 	// The OS name. Can be one of:
 	//  GNU/Linux
 	//  FreeBSD
@@ -96,16 +214,53 @@ type UserAgent struct {
 	//  CrOS
 	//   etc.
 	// If the os is not known, OS will be `unknown'.
-	OS        string
+	OS string
+	// On Windows it's the NT version (e.g. 6.1 for Windows 7) but for ParseHints
+	// it's Sec-CH-UA-Platform-Version (e.g. 15.0.0 for Windows 11),
+	// use OSVersionName to get the release name.
 	OSVersion semver.Version
-	Security  Security
+	// The GNU/Linux distribution (e.g. Ubuntu, Fedora, Debian) and its version
+	// as found in the user agent string (e.g. 38, 3.6.3-4.fc13).
+	// If unknown are empty.
+	Distribution        string
+	DistributionVersion string
+	// The CPU architecture, as in Sec-CH-UA-Arch: x86, arm, ppc, mips, sparc.
+	// If unknown is empty. Reduced UAs only have it from ParseHints.
+	Arch string
+	// 32 or 64. If unknown is 0.
+	Bitness  int
+	Security Security
 	// URL with more information about the user agent (in most cases it's the home page).
 	// If unknown is nil.
 	URL *url.URL
-	// Is it a phone device?
+	// Company or project running a crawler (or previewer, or monitor, or validator), or the vendor of a scanner.
+	// If unknown is empty.
+	Operator string
+	// Why a crawler fetches pages. See also UserAgent.Crawler.
+	Purpose Purpose
+	// Number of subscribers reported by feed readers. If unknown is 0.
+	Subscribers int
+	// Is it a phone device? Same as Device == DevicePhone || Device == DeviceFeaturePhone.
 	Mobile bool
-	// Is it a tablet device?
+	// Is it a tablet device? Same as Device == DeviceTablet.
 	Tablet bool
+	Device DeviceType
+	// The device manufacturer (e.g. Samsung, Apple, Google).
+	// If unknown is empty.
+	DeviceVendor string
+	// The device marketing name (e.g. Galaxy S23 Ultra, iPhone 14 Pro) if known,
+	// otherwise the model code found in the user agent string (e.g. SM-X123).
+	// If unknown is empty.
+	DeviceModel string
+	// Is it a Chromium reduced user agent string? If so Chrome's version is frozen
+	// to MAJOR.0.0.0 (derivatives like Edg/120.0.2210.91 still send their real version)
+	// and OSVersion is unknown if the platform is one of the frozen placeholders
+	// (Android 10, Mac OS X 10.15.7, Windows NT 10.0 on x64).
+	// Use ParseHints to get the real values.
+	Reduced bool
+
+	// OSVersion comes from Sec-CH-UA-Platform-Version
+	osVersionFromHints bool
 }
 
 func (ua *UserAgent) String() string {
@@ -119,9 +274,17 @@ Mobile: %v
 Tablet: %v`, ua.Type, ua.Name, ua.Version, ua.OS, ua.OSVersion, ua.Security, ua.Mobile, ua.Tablet)
 }
 
+// Set the device type keeping Mobile and Tablet in sync
+func (ua *UserAgent) setDevice(d DeviceType) {
+	ua.Device = d
+	ua.Mobile = d == DevicePhone || d == DeviceFeaturePhone
+	ua.Tablet = d == DeviceTablet
+}
+
 func new() *UserAgent {
 	ua := &UserAgent{}
 	ua.Name = "unknown"
+	ua.Engine = "unknown"
 	ua.OS = "unknown"
 	return ua
 }
@@ -140,7 +303,7 @@ type parseFn func(l *lex) *UserAgent
 // Since user agent strings don't have a standard, this function uses heuristics.
 func Parse(uas string) *UserAgent {
 	// NOTE: parse functions order matters.
-	for _, f := range []parseFn{parseCrawler, parseBrowser, parseGeneric} {
+	for _, f := range []parseFn{parseCrawler, parsePreviewer, parseMonitor, parseScanner, parseLinkChecker, parseValidator, parseFeedReader, parseBrowser, parseLibrary, parseGeneric} {
 		if ua := f(newLex(uas)); ua != nil {
 			ua.Original = uas
 			return ua
@@ -150,7 +313,13 @@ func Parse(uas string) *UserAgent {
 }
 
 func parseVersion(l *lex, ua *UserAgent, sep string) bool {
-	var err error
+	var ok bool
+	ua.Version, ok = lexVersion(l, sep)
+	return ok
+}
+
+// Consume a version up to sep (or the end of the string)
+func lexVersion(l *lex, sep string) (semver.Version, bool) {
 	var s string
 	var ok bool
 
@@ -158,7 +327,7 @@ func parseVersion(l *lex, ua *UserAgent, sep string) bool {
 		s = l.s[l.p:]
 		l.p = len(l.s)
 		if s == "" {
-			return false
+			return semver.Version{}, false
 		}
 	}
 
@@ -166,39 +335,39 @@ func parseVersion(l *lex, ua *UserAgent, sep string) bool {
 	//  some versions have extra dot fields (instead of only 3)
 	//  we try to detect this and remove all the extra stuff
 	//   e.g. X.Y.Z.Q.W-beta -> X.Y.Z-beta
-	//  others miss the `patch` field in their version
-	//  so we add a fictious one
-	//   e.g. X.Y -> X.Y.0
+	//  others miss the `minor` and/or `patch` fields in their version
+	//  so we add fictious ones
+	//   e.g. X.Y -> X.Y.0, X -> X.0.0 (only if X is a number)
 	//  We also strip leading zeroes from each number so that
 	//   semver is happy parsing them.
 
 	hypen := strings.SplitN(s, "-", 2)
 	fs := strings.Split(hypen[0], ".")
-	maxfs := 3
-	if len(fs) < 3 {
-		if len(fs) == 2 {
+	if len(fs) == 2 || len(fs) == 1 && isNumber(fs[0]) {
+		for len(fs) < 3 {
 			fs = append(fs, "0")
-		} else {
-			maxfs = len(fs)
 		}
 	}
+	if len(fs) > 3 {
+		fs = fs[:3]
+	}
 	for i := range fs {
 		fs[i] = strings.TrimLeft(fs[i], "0")
 		if len(fs[i]) == 0 {
 			fs[i] = "0"
 		}
 	}
-	s = strings.Join(fs[:maxfs], ".")
+	s = strings.Join(fs, ".")
 	if len(hypen) > 1 {
 		s += "-" + hypen[1]
 	}
 
-	ua.Version, err = semver.Parse(s)
+	v, err := semver.Parse(s)
 	if err != nil {
-		return false
+		return semver.Version{}, false
 	}
 
-	return true
+	return v, true
 }
 
 // Regexps need to match start of string to prevent greedily finding
@@ -206,6 +375,9 @@ func parseVersion(l *lex, ua *UserAgent, sep string) bool {
 var appleVersionRegexp = regexp.MustCompile(`^(?:[^\)]+?)\b(\d+_\d+(_\d+)?)\b`)
 var genericVersionRegexp = regexp.MustCompile(`^(?:[^\)]*?) (\d+\.\d+(\.\d+)?)\b`)
 
+// Since Android 10 only the major version is sent
+var androidVersionRegexp = regexp.MustCompile(`^(?:[^\)]*?) (\d+(\.\d+(\.\d+)?)?)\b`)
+
 func parseOSVersion(l *lex, ua *UserAgent) bool {
 	switch ua.OS {
 	case OSMacOS, OSiOS:
@@ -225,7 +397,11 @@ func parseOSVersion(l *lex, ua *UserAgent) bool {
 		return true
 
 	case OSAndroid, OSWindows:
-		_, s, ok := l.spanRegexp(genericVersionRegexp)
+		re := genericVersionRegexp
+		if ua.OS == OSAndroid {
+			re = androidVersionRegexp
+		}
+		_, s, ok := l.spanRegexp(re)
 		if !ok {
 			return true
 		}
@@ -243,6 +419,61 @@ func parseOSVersion(l *lex, ua *UserAgent) bool {
 	}
 }
 
+// A token identifying an agent and the name to report for it
+type product struct {
+	token string
+	name  string
+}
+
+// Look anywhere in the UA string for the first product of ps, in order,
+// matching only whole product tokens (see spanToken).
+// If the token is followed by (or ends with) a slash the version is read too.
+// urls is nil for agents in the crawler registry, see setCrawlerInfo.
+// Returns nil if no product is found.
+func parseProduct(l *lex, t Type, ps []product, urls map[string]*url.URL) *UserAgent {
+	for _, p := range ps {
+		pl := newLex(l.s)
+		if _, ok := pl.spanToken(p.token); !ok {
+			continue
+		}
+		ua := new()
+		ua.Type = t
+		ua.Name = p.name
+		ua.URL = urls[p.name]
+		if strings.HasSuffix(p.token, "/") || pl.match("/") {
+			// versions aren't required
+			ua.Version, _ = lexProductVersion(pl)
+		}
+		return ua
+	}
+	return nil
+}
+
+// Consume a product version, which ends at a space or punctuation (e.g. "sqlmap/1.7.2#stable")
+func lexProductVersion(l *lex) (semver.Version, bool) {
+	i := strings.IndexAny(l.s[l.p:], " ;)(,#")
+	if i < 0 {
+		i = len(l.s) - l.p
+	}
+	s := l.s[l.p : l.p+i]
+	l.p += i
+	// e.g. "MJ12bot/v1.4.8"
+	s = strings.TrimPrefix(s, "v")
+	return lexVersion(newLex(s), " ")
+}
+
+func isNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
 func parseNameVersion(l *lex, ua *UserAgent) bool {
 	var s string
 	var ok bool
@@ -273,9 +504,9 @@ func parseGeneric(l *lex) *UserAgent {
 		return ua
 	}
 
-	if url, ok := crawlers[ua.Name]; ok {
+	if _, ok := crawlers[ua.Name]; ok {
 		ua.Type = Crawler
-		ua.URL = url
+		setCrawlerInfo(ua)
 		return ua
 	}
 
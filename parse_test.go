@@ -54,7 +54,8 @@ func eqUA(a *UserAgent, b *UserAgent) bool {
 		!a.Version.EQ(b.Version) ||
 		a.Security != b.Security ||
 		a.Mobile != b.Mobile ||
-		a.Tablet != b.Tablet {
+		a.Tablet != b.Tablet ||
+		a.Reduced != b.Reduced {
 		return false
 	}
 	return true
@@ -269,6 +270,20 @@ func TestChrome(t *testing.T) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
 	}
 
+	// the stock browser, Mobile comes before the Safari token
+	got = Parse(`Mozilla/5.0 (Linux; U; Android 4.1.2; en-us; GT-I9300 Build/JZO54K) AppleWebKit/534.30 (KHTML, like Gecko) Version/4.0 Mobile Safari/534.30`)
+	want.Type = Browser
+	want.OS = "Android"
+	want.OSVersion = mustParse("4.1.2")
+	want.Name = "Safari"
+	want.Version = mustParse("4.0.0")
+	want.Security = SecurityStrong
+	want.Mobile = true
+	want.Tablet = false
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
 	got = Parse(`Mozilla/5.0 (Macintosh; Intel Mac OS X 10_12_0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/53.0.2785.143 Safari/537.36`)
 	want.Type = Browser
 	want.OS = "Mac OS X"
@@ -283,6 +298,80 @@ func TestChrome(t *testing.T) {
 	}
 }
 
+func TestReduced(t *testing.T) {
+	var got *UserAgent
+	want := &UserAgent{}
+
+	got = Parse(`Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36`)
+	want.Type = Browser
+	want.OS = "Android"
+	want.OSVersion = semver.Version{}
+	want.Name = "Chrome"
+	want.Version = mustParse("120.0.0")
+	want.Security = SecurityUnknown
+	want.Mobile = true
+	want.Reduced = true
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36`)
+	want.Type = Browser
+	want.OS = "Mac OS X"
+	want.OSVersion = semver.Version{}
+	want.Name = "Chrome"
+	want.Version = mustParse("120.0.0")
+	want.Security = SecurityUnknown
+	want.Mobile = false
+	want.Reduced = true
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36`)
+	want.Type = Browser
+	want.OS = "Windows"
+	want.OSVersion = semver.Version{}
+	want.Name = "Chrome"
+	want.Version = mustParse("120.0.0")
+	want.Security = SecurityUnknown
+	want.Reduced = true
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	if got.OSVersionName() != "" {
+		t.Errorf("expected no OS version name, got %s", got.OSVersionName())
+	}
+
+	// Not reduced: the real Windows version is kept
+	got = Parse(`Mozilla/5.0 (Windows NT 6.1; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/109.0.5414.120 Safari/537.36`)
+	want.Type = Browser
+	want.OS = "Windows"
+	want.OSVersion = mustParse("6.1")
+	want.Name = "Chrome"
+	want.Version = mustParse("109.0.5414")
+	want.Security = SecurityUnknown
+	want.Reduced = false
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	// Not reduced: the real Android version is kept
+	got = Parse(`Mozilla/5.0 (Linux; Android 10; SM-G973F) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/99.0.4844.88 Mobile Safari/537.36`)
+	want.Type = Browser
+	want.OS = "Android"
+	want.OSVersion = mustParse("10")
+	want.Name = "Chrome"
+	want.Version = mustParse("99.0.4844")
+	want.Security = SecurityUnknown
+	want.Mobile = true
+	want.Reduced = false
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+}
+
 // Android's Chromium-based web rendering library
 func TestWebView(t *testing.T) {
 	var got *UserAgent
@@ -313,6 +402,20 @@ func TestWebView(t *testing.T) {
 	if !eqUA(want, got) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
 	}
+
+	// iOS apps' web views have no browser token
+	got = Parse(`Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 [FBAN/FBIOS;FBDV/iPhone15,2;FBMD/iPhone;FBSN/iOS;FBSV/17.0;FBSS/3;FBID/phone;FBLC/en_US;FBOP/5]`)
+	want.Type = Library
+	want.OS = "iOS"
+	want.OSVersion = mustParse("17.0")
+	want.Name = "WebView"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Mobile = true
+	want.Tablet = false
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
 }
 
 func TestSafari(t *testing.T) {
@@ -410,276 +513,3537 @@ func TestEdge(t *testing.T) {
 	if !eqUA(want, got) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
 	}
-}
-
-func TestGeneric(t *testing.T) {
-	var got *UserAgent
-	want := &UserAgent{}
 
-	got = Parse(`Dillo/0.8.6-i18n-misc`)
+	// Chromium-based Edge
+	got = Parse(`Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0.2210.91`)
 	want.Type = Browser
-	want.OS = "unknown"
+	want.OS = "Windows"
 	want.OSVersion = semver.Version{}
-	want.Name = "Dillo"
-	want.Version = mustParse("0.8.6-i18n-misc")
+	want.Name = "Edge"
+	want.Version = mustParse("120.0.2210")
+	want.Mobile = false
 	want.Security = SecurityUnknown
-	want.URL = u("http://www.dillo.org/")
+	want.Reduced = true
 	if !eqUA(want, got) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
 	}
 
-}
-
-func TestPhantomJS(t *testing.T) {
-	var got *UserAgent
-	want := &UserAgent{}
-	want.Mobile = false
-	want.Tablet = false
-
-	got = Parse(`Mozilla/5.0 (Macintosh; Intel Mac OS X) AppleWebKit/538.1 (KHTML, like Gecko) PhantomJS/2.0.0 Safari/538.1`)
-	want.Type = Library
-	want.OS = "Mac OS X"
-	want.OSVersion = semver.Version{}
-	want.Name = "PhantomJS"
-	want.Version = mustParse("2.0.0")
+	got = Parse(`Mozilla/5.0 (Linux; Android 13; SM-A536B) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/119.0.6045.163 Mobile Safari/537.36 EdgA/119.0.2151.78`)
+	want.Type = Browser
+	want.OS = "Android"
+	want.OSVersion = mustParse("13")
+	want.Name = "Edge"
+	want.Version = mustParse("119.0.2151")
+	want.Mobile = true
 	want.Security = SecurityUnknown
+	want.Reduced = false
 	if !eqUA(want, got) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
 	}
 
-	got = Parse(`Mozilla/5.0 (Macintosh; Intel Mac OS X) AppleWebKit/534.34 (KHTML, like Gecko) PhantomJS/1.9.0 (development) Safari/534.34`)
-	want.Type = Library
-	want.OS = "Mac OS X"
-	want.OSVersion = semver.Version{}
-	want.Name = "PhantomJS"
-	want.Version = mustParse("1.9.0")
+	got = Parse(`Mozilla/5.0 (iPhone; CPU iPhone OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 EdgiOS/119.2151.65 Mobile/15E148 Safari/605.1.15`)
+	want.Type = Browser
+	want.OS = "iOS"
+	want.OSVersion = mustParse("17.1")
+	want.Name = "Edge"
+	want.Version = mustParse("119.2151.65")
+	want.Mobile = true
 	want.Security = SecurityUnknown
 	if !eqUA(want, got) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
 	}
 
-	got = Parse(`Mozilla/5.0 (Unknown; Linux x86_64) AppleWebKit/538.1 (KHTML, like Gecko) PhantomJS/2.1.1 Safari/538.1`)
-	want.Type = Library
-	want.OS = "GNU/Linux"
-	want.OSVersion = semver.Version{}
-	want.Name = "PhantomJS"
-	want.Version = mustParse("2.1.1")
+	got = Parse(`Mozilla/5.0 (iPad; CPU OS 16_6 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) EdgiOS/116.0.1938.72 Mobile/15E148 Safari/605.1.15`)
+	want.Type = Browser
+	want.OS = "iOS"
+	want.OSVersion = mustParse("16.6")
+	want.Name = "Edge"
+	want.Version = mustParse("116.0.1938")
+	want.Mobile = false
+	want.Tablet = true
 	want.Security = SecurityUnknown
 	if !eqUA(want, got) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
 	}
 }
 
-func TestOpera(t *testing.T) {
+func TestChromeDerivatives(t *testing.T) {
 	var got *UserAgent
 	want := &UserAgent{}
-	want.Mobile = false
-	want.Tablet = false
 
-	got = Parse(`Opera/4.02 (Windows 98; U) [de]`)
+	got = Parse(`Mozilla/5.0 (Linux; Android 13; SM-S918B) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/23.0 Chrome/115.0.0.0 Mobile Safari/537.36`)
 	want.Type = Browser
-	want.OS = "Windows"
-	want.OSVersion = semver.Version{}
-	want.Name = "Opera"
-	want.Version = mustParse("4.2.0")
-	want.Security = SecurityStrong
+	want.OS = "Android"
+	want.OSVersion = mustParse("13")
+	want.Name = "Samsung Internet"
+	want.Version = mustParse("23.0")
+	want.Security = SecurityUnknown
+	want.Mobile = true
+	want.Reduced = true
 	if !eqUA(want, got) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
 	}
 
-	got = Parse(`Opera/9.30 (Macintosh; PPC Mac OS X; U; ja)`)
+	got = Parse(`Mozilla/5.0 (Linux; U; Android 10; en-US; RMX1911 Build/QKQ1.200209.002) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/78.0.3904.108 UCBrowser/13.4.0.1306 Mobile Safari/537.36`)
 	want.Type = Browser
-	want.OS = "Mac OS X"
-	want.OSVersion = semver.Version{}
-	want.Name = "Opera"
-	want.Version = mustParse("9.30.0")
+	want.OS = "Android"
+	want.OSVersion = mustParse("10")
+	want.Name = "UC Browser"
+	want.Version = mustParse("13.4.0")
 	want.Security = SecurityStrong
+	want.Mobile = true
+	want.Reduced = false
 	if !eqUA(want, got) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
 	}
 
-	got = Parse(`Opera/7.52 (FreeBSD 4.7-RELEASE i386; U) [fr]`)
+	// without Chrome's token Mobile is skipped with UCBrowser's
+	got = Parse(`Mozilla/5.0 (Linux; U; Android 10; en-US; RMX2020 Build/QP1A.190711.020) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 UCBrowser/13.4.0.1306 Mobile Safari/537.36`)
 	want.Type = Browser
-	want.OS = "FreeBSD"
-	want.OSVersion = semver.Version{}
-	want.Name = "Opera"
-	want.Version = mustParse("7.52.0")
+	want.OS = "Android"
+	want.OSVersion = mustParse("10")
+	want.Name = "UC Browser"
+	want.Version = mustParse("13.4.0")
 	want.Security = SecurityStrong
+	want.Mobile = true
+	want.Reduced = false
 	if !eqUA(want, got) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
 	}
 
-	got = Parse(`Opera/9.80 (Windows NT 6.1; U; en) Presto/2.10.229 Version/11.61`)
+	got = Parse(`Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/116.0.0.0 YaBrowser/23.9.0.0 Safari/537.36`)
 	want.Type = Browser
 	want.OS = "Windows"
-	want.OSVersion = mustParse("6.1.0")
-	want.Name = "Opera"
-	want.Version = mustParse("11.61.0")
-	want.Security = SecurityStrong
+	want.OSVersion = semver.Version{}
+	want.Name = "Yandex Browser"
+	want.Version = mustParse("23.9.0")
+	want.Security = SecurityUnknown
+	want.Mobile = false
+	want.Reduced = true
 	if !eqUA(want, got) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
 	}
 
-	got = Parse(`Opera/9.80 (Linux mips; U; HbbTV/1.1.1 (; TechniSat; DigiPal ISIO HD; 2.70.0.5; 57.0-6; ); CE-HTML/1.0 (); MB_BP/1.0 (TechniSat; DigiPal ISIO HD; ); TechniSat DigiPal ISIO HD BCM3 STB; de) Presto/2.12.407 Version/12.51`)
+	got = Parse(`Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/116.0.5845.188 Safari/537.36 Vivaldi/6.2.3105.48`)
 	want.Type = Browser
 	want.OS = "GNU/Linux"
 	want.OSVersion = semver.Version{}
-	want.Name = "Opera"
-	want.Version = mustParse("12.51.0")
-	want.Security = SecurityStrong
+	want.Name = "Vivaldi"
+	want.Version = mustParse("6.2.3105")
+	want.Security = SecurityUnknown
+	want.Reduced = false
 	if !eqUA(want, got) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
 	}
 
-	got = Parse(`Mozilla/4.0 (compatible; MSIE 8.0; Windows NT 6.0; zh-tw) Opera 11.00`)
+	got = Parse(`Mozilla/5.0 (Linux; Android 12; M2101K6G Build/SKQ1.210908.001) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/100.0.4896.127 Mobile Safari/537.36 XiaoMi/MiuiBrowser/13.9.0-gn`)
 	want.Type = Browser
-	want.OS = "Windows"
-	want.OSVersion = mustParse("6.0.0")
-	want.Name = "Opera"
-	want.Version = mustParse("11.0.0")
+	want.OS = "Android"
+	want.OSVersion = mustParse("12")
+	want.Name = "MIUI Browser"
+	want.Version = mustParse("13.9.0-gn")
 	want.Security = SecurityUnknown
+	want.Mobile = true
 	if !eqUA(want, got) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
 	}
 
-	got = Parse(`Mozilla/5.0 (Windows NT 6.1; U; nl; rv:1.9.1.6) Gecko/20091201 Firefox/3.5.6 Opera 11.01`)
+	got = Parse(`Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Mobile/15E148 DuckDuckGo/7 Safari/605.1.15`)
 	want.Type = Browser
-	want.OS = "Windows"
-	want.OSVersion = mustParse("6.1.0")
-	want.Name = "Opera"
-	want.Version = mustParse("11.1.0")
-	want.Security = SecurityStrong
+	want.OS = "iOS"
+	want.OSVersion = mustParse("17.0")
+	want.Name = "DuckDuckGo"
+	want.Version = mustParse("7")
+	want.Security = SecurityUnknown
+	want.Mobile = true
 	if !eqUA(want, got) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
 	}
 
-	got = Parse(`Mozilla/5.0 (Windows NT 6.1; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/28.0.1500.52 Safari/537.36 OPR/15.0.1147.100`)
+	got = Parse(`Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/119.0.0.0 Safari/537.36 Edg/119.0.0.0 Ddg/119.0.0.0`)
 	want.Type = Browser
 	want.OS = "Windows"
-	want.OSVersion = mustParse("6.1.0")
-	want.Name = "Opera"
-	want.Version = mustParse("15.0.1147")
+	want.OSVersion = semver.Version{}
+	want.Name = "DuckDuckGo"
+	want.Version = mustParse("119.0.0")
 	want.Security = SecurityUnknown
+	want.Mobile = false
+	want.Reduced = true
 	if !eqUA(want, got) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
 	}
 
-	got = Parse(`Mozilla/5.0 (Linux; Android 4.0.4; Galaxy Nexus Build/IMM76B) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/28.0.1500.52 Mobile Safari/537.36 OPR/15.0.1147.100`)
-	want.Type = Browser
-	want.OS = "Android"
-	want.OSVersion = mustParse("4.0.4")
-	want.Name = "Opera"
-	want.Version = mustParse("15.0.1147")
-	want.Security = SecurityUnknown
-	want.Mobile = true
-	if !eqUA(want, got) {
-		t.Errorf("expected %+v, got %+v\n", want, got)
+	for _, d := range chromeDerivatives {
+		if _, ok := browsers[d.name]; !ok {
+			t.Errorf("%s is not in browsers", d.name)
+		}
 	}
 }
 
-func TestGoogleBot(t *testing.T) {
+func TestEngine(t *testing.T) {
 	var got *UserAgent
 	want := &UserAgent{}
-	want.Mobile = false
-	want.Tablet = false
 
-	got = Parse(`Googlebot/2.1 (+http://www.google.com/bot.html)`)
-	want.Type = Crawler
-	want.OS = "unknown"
+	got = Parse(`Mozilla/5.0 (X11; Linux i686; rv:38.0) Gecko/20100101 Firefox/38.0`)
+	want.Type = Browser
+	want.OS = "GNU/Linux"
 	want.OSVersion = semver.Version{}
-	want.Name = "Googlebot"
-	want.Version = mustParse("2.1.0")
+	want.Name = "Firefox"
+	want.Version = mustParse("38.0.0")
 	want.Security = SecurityUnknown
+	want.Engine = EngineGecko
+	want.EngineVersion = mustParse("38.0.0")
 	if !eqUA(want, got) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Engine != want.Engine || !got.EngineVersion.EQ(want.EngineVersion) {
+		t.Errorf("expected %s %s, got %s %s\n", want.Engine, want.EngineVersion, got.Engine, got.EngineVersion)
 	}
 
-	got = Parse(`Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)`)
-	want.Type = Crawler
-	want.OS = "unknown"
-	want.OSVersion = semver.Version{}
-	want.Name = "Googlebot"
-	want.Version = mustParse("2.1")
+	got = Parse(`Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:102.0) Gecko/20100101 Goanna/6.3 Firefox/102.0 PaleMoon/32.4.0`)
+	want.Type = Browser
+	want.OS = "Windows"
+	want.OSVersion = mustParse("10.0.0")
+	want.Name = "Pale Moon"
+	want.Version = mustParse("32.4.0")
 	want.Security = SecurityUnknown
+	want.Engine = EngineGoanna
+	want.EngineVersion = mustParse("6.3.0")
 	if !eqUA(want, got) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Engine != want.Engine || !got.EngineVersion.EQ(want.EngineVersion) {
+		t.Errorf("expected %s %s, got %s %s\n", want.Engine, want.EngineVersion, got.Engine, got.EngineVersion)
 	}
 
-	got = Parse(`Googlebot-News`)
-	want.Type = Crawler
-	want.OS = "unknown"
-	want.OSVersion = semver.Version{}
-	want.Name = "Googlebot News"
-	want.Version = semver.Version{}
-	want.Security = SecurityUnknown
+	got = Parse(`Mozilla/5.0 (Windows NT 6.1; U; nl; rv:1.9.1.6) Gecko/20091201 Firefox/3.5.6 Opera 11.01`)
+	want.Type = Browser
+	want.OS = "Windows"
+	want.OSVersion = mustParse("6.1.0")
+	want.Name = "Opera"
+	want.Version = mustParse("11.1.0")
+	want.Security = SecurityStrong
+	want.Engine = EnginePresto
+	want.EngineVersion = semver.Version{}
 	if !eqUA(want, got) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Engine != want.Engine || !got.EngineVersion.EQ(want.EngineVersion) {
+		t.Errorf("expected %s %s, got %s %s\n", want.Engine, want.EngineVersion, got.Engine, got.EngineVersion)
 	}
 
-	got = Parse(`Googlebot-Image/1.0`)
-	want.Type = Crawler
-	want.OS = "unknown"
+	got = Parse(`Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/41.0.2227.0 Safari/537.36`)
+	want.Type = Browser
+	want.OS = "GNU/Linux"
 	want.OSVersion = semver.Version{}
-	want.Name = "Googlebot Images"
-	want.Version = mustParse("1.0")
+	want.Name = "Chrome"
+	want.Version = mustParse("41.0.2227")
 	want.Security = SecurityUnknown
+	want.Engine = EngineBlink
+	want.EngineVersion = mustParse("41.0.2227")
 	if !eqUA(want, got) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Engine != want.Engine || !got.EngineVersion.EQ(want.EngineVersion) {
+		t.Errorf("expected %s %s, got %s %s\n", want.Engine, want.EngineVersion, got.Engine, got.EngineVersion)
 	}
 
-	got = Parse(`Googlebot-Video/1.0`)
-	want.Type = Crawler
-	want.OS = "unknown"
+	got = Parse(`Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) HeadlessChrome/120.0.0.0 Safari/537.36`)
+	want.Type = Browser
+	want.OS = "GNU/Linux"
 	want.OSVersion = semver.Version{}
-	want.Name = "Googlebot Video"
-	want.Version = mustParse("1.0")
+	want.Name = "HeadlessChrome"
+	want.Version = mustParse("120.0.0")
 	want.Security = SecurityUnknown
+	want.Reduced = true
+	want.Engine = EngineBlink
+	want.EngineVersion = mustParse("120.0.0")
 	if !eqUA(want, got) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Engine != want.Engine || !got.EngineVersion.EQ(want.EngineVersion) {
+		t.Errorf("expected %s %s, got %s %s\n", want.Engine, want.EngineVersion, got.Engine, got.EngineVersion)
 	}
 
-	got = Parse(`Mediapartners-Google`)
-	want.Type = Crawler
-	want.OS = "unknown"
-	want.OSVersion = semver.Version{}
-	want.Name = "Google AdSense"
-	want.Version = semver.Version{}
-	want.Security = SecurityUnknown
-	if !eqUA(want, got) {
+	got = Parse(`Mozilla/5.0 (Linux; Android 4.0.4; Galaxy Nexus Build/IMM76B) AppleWebKit/535.19 (KHTML, like Gecko) Chrome/18.0.1025.133 Mobile Safari/535.19`)
+	want.Type = Browser
+	want.OS = "Android"
+	want.OSVersion = mustParse("4.0.4")
+	want.Name = "Chrome"
+	want.Version = mustParse("18.0.1025")
+	want.Security = SecurityUnknown
+	want.Reduced = false
+	want.Mobile = true
+	want.Engine = EngineWebKit
+	want.EngineVersion = mustParse("535.19.0")
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Engine != want.Engine || !got.EngineVersion.EQ(want.EngineVersion) {
+		t.Errorf("expected %s %s, got %s %s\n", want.Engine, want.EngineVersion, got.Engine, got.EngineVersion)
+	}
+
+	got = Parse(`Mozilla/5.0 (iPhone; U; CPU iPhone OS 5_1_1 like Mac OS X; en) AppleWebKit/534.46.0 (KHTML, like Gecko) CriOS/19.0.1084.60 Mobile/9B206 Safari/7534.48.3`)
+	want.Type = Browser
+	want.OS = "iOS"
+	want.OSVersion = mustParse("5.1.1")
+	want.Name = "Chrome"
+	want.Version = mustParse("19.0.1084")
+	want.Security = SecurityStrong
+	want.Engine = EngineWebKit
+	want.EngineVersion = mustParse("534.46.0")
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Engine != want.Engine || !got.EngineVersion.EQ(want.EngineVersion) {
+		t.Errorf("expected %s %s, got %s %s\n", want.Engine, want.EngineVersion, got.Engine, got.EngineVersion)
+	}
+
+	got = Parse(`Mozilla/5.0 (Macintosh; Intel Mac OS X 10_6_8) AppleWebKit/537.13+ (KHTML, like Gecko) Version/5.1.7 Safari/534.57.2`)
+	want.Type = Browser
+	want.OS = "Mac OS X"
+	want.OSVersion = mustParse("10.6.8")
+	want.Name = "Safari"
+	want.Version = mustParse("5.1.7")
+	want.Security = SecurityUnknown
+	want.Mobile = false
+	want.Engine = EngineWebKit
+	want.EngineVersion = semver.Version{}
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Engine != want.Engine || !got.EngineVersion.EQ(want.EngineVersion) {
+		t.Errorf("expected %s %s, got %s %s\n", want.Engine, want.EngineVersion, got.Engine, got.EngineVersion)
+	}
+
+	got = Parse(`Mozilla/5.0 (Windows NT 10.0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/42.0.2311.135 Safari/537.36 Edge/12.10136`)
+	want.Type = Browser
+	want.OS = "Windows"
+	want.OSVersion = mustParse("10.0.0")
+	want.Name = "Edge"
+	want.Version = mustParse("12.10136.0")
+	want.Security = SecurityUnknown
+	want.Engine = EngineEdgeHTML
+	want.EngineVersion = mustParse("12.10136.0")
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Engine != want.Engine || !got.EngineVersion.EQ(want.EngineVersion) {
+		t.Errorf("expected %s %s, got %s %s\n", want.Engine, want.EngineVersion, got.Engine, got.EngineVersion)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; MSIE 10.0; Windows NT 6.1; Trident/6.0)`)
+	want.Type = Browser
+	want.OS = "Windows"
+	want.OSVersion = mustParse("6.1.0")
+	want.Name = "MSIE"
+	want.Version = mustParse("10.0.0")
+	want.Security = SecurityUnknown
+	want.Engine = EngineTrident
+	want.EngineVersion = mustParse("6.0.0")
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Engine != want.Engine || !got.EngineVersion.EQ(want.EngineVersion) {
+		t.Errorf("expected %s %s, got %s %s\n", want.Engine, want.EngineVersion, got.Engine, got.EngineVersion)
+	}
+
+	got = Parse(`Mozilla/5.0 (Windows NT 6.3; Trident/7.0; .NET4.0E; .NET4.0C; rv:11.0) like Gecko`)
+	want.Type = Browser
+	want.OS = "Windows"
+	want.OSVersion = mustParse("6.3.0")
+	want.Name = "MSIE"
+	want.Version = mustParse("11.0.0")
+	want.Security = SecurityUnknown
+	want.Engine = EngineTrident
+	want.EngineVersion = mustParse("7.0.0")
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Engine != want.Engine || !got.EngineVersion.EQ(want.EngineVersion) {
+		t.Errorf("expected %s %s, got %s %s\n", want.Engine, want.EngineVersion, got.Engine, got.EngineVersion)
+	}
+
+	got = Parse(`Opera/9.80 (Windows NT 6.1; U; en) Presto/2.10.229 Version/11.61`)
+	want.Type = Browser
+	want.OS = "Windows"
+	want.OSVersion = mustParse("6.1.0")
+	want.Name = "Opera"
+	want.Version = mustParse("11.61.0")
+	want.Security = SecurityStrong
+	want.Engine = EnginePresto
+	want.EngineVersion = mustParse("2.10.229")
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Engine != want.Engine || !got.EngineVersion.EQ(want.EngineVersion) {
+		t.Errorf("expected %s %s, got %s %s\n", want.Engine, want.EngineVersion, got.Engine, got.EngineVersion)
+	}
+}
+
+func TestDevice(t *testing.T) {
+	var got *UserAgent
+	want := &UserAgent{}
+
+	got = Parse(`Mozilla/5.0 (X11; Linux i686; rv:38.0) Gecko/20100101 Firefox/38.0`)
+	want.Type = Browser
+	want.OS = "GNU/Linux"
+	want.OSVersion = semver.Version{}
+	want.Name = "Firefox"
+	want.Version = mustParse("38.0.0")
+	want.Security = SecurityUnknown
+	want.Device = DeviceDesktop
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Device != want.Device {
+		t.Errorf("expected %s, got %s\n", want.Device, got.Device)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; MSIE 10.0; Windows NT 6.1; Trident/6.0)`)
+	want.Type = Browser
+	want.OS = "Windows"
+	want.OSVersion = mustParse("6.1.0")
+	want.Name = "MSIE"
+	want.Version = mustParse("10.0.0")
+	want.Security = SecurityUnknown
+	want.Device = DeviceDesktop
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Device != want.Device {
+		t.Errorf("expected %s, got %s\n", want.Device, got.Device)
+	}
+
+	got = Parse(`Mozilla/5.0 (Linux; Android 4.0.4; Galaxy Nexus Build/IMM76B) AppleWebKit/535.19 (KHTML, like Gecko) Chrome/18.0.1025.133 Mobile Safari/535.19`)
+	want.Type = Browser
+	want.OS = "Android"
+	want.OSVersion = mustParse("4.0.4")
+	want.Name = "Chrome"
+	want.Version = mustParse("18.0.1025")
+	want.Security = SecurityUnknown
+	want.Mobile = true
+	want.Device = DevicePhone
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Device != want.Device {
+		t.Errorf("expected %s, got %s\n", want.Device, got.Device)
+	}
+
+	got = Parse(`Mozilla/5.0 (iPad; CPU iPhone OS 8_3 like Mac OS X) AppleWebKit/600.1.4 (KHTML, like Gecko) FxiOS/1.0 Mobile/12F69 Safari/600.1.4`)
+	want.Type = Browser
+	want.OS = "iOS"
+	want.OSVersion = mustParse("8.3.0")
+	want.Name = "Firefox"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	want.Mobile = false
+	want.Tablet = true
+	want.Device = DeviceTablet
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Device != want.Device {
+		t.Errorf("expected %s, got %s\n", want.Device, got.Device)
+	}
+
+	got = Parse(`Mozilla/5.0 (Linux; Android 9; BRAVIA 4K GB Build/PTT1.190515.001.S52) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/91.0.4472.120 Safari/537.36`)
+	want.Type = Browser
+	want.OS = "Android"
+	want.OSVersion = mustParse("9.0.0")
+	want.Name = "Chrome"
+	want.Version = mustParse("91.0.4472")
+	want.Security = SecurityUnknown
+	want.Tablet = false
+	want.Device = DeviceTV
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Device != want.Device {
+		t.Errorf("expected %s, got %s\n", want.Device, got.Device)
+	}
+
+	got = Parse(`Opera/9.80 (Linux mips; U; HbbTV/1.1.1 (; TechniSat; DigiPal ISIO HD; 2.70.0.5; 57.0-6; ); CE-HTML/1.0 (); MB_BP/1.0 (TechniSat; DigiPal ISIO HD; ); TechniSat DigiPal ISIO HD BCM3 STB; de) Presto/2.12.407 Version/12.51`)
+	want.Type = Browser
+	want.OS = "GNU/Linux"
+	want.OSVersion = semver.Version{}
+	want.Name = "Opera"
+	want.Version = mustParse("12.51.0")
+	want.Security = SecurityStrong
+	want.Device = DeviceTV
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Device != want.Device {
+		t.Errorf("expected %s, got %s\n", want.Device, got.Device)
+	}
+
+	got = Parse(`Mozilla/5.0 (X11; Linux x86_64; Quest 2) AppleWebKit/537.36 (KHTML, like Gecko) OculusBrowser/16.6.0.1.52.314146309 SamsungBrowser/4.0 Chrome/91.0.4472.164 VR Safari/537.36`)
+	want.Type = Browser
+	want.OS = "GNU/Linux"
+	want.OSVersion = semver.Version{}
+	want.Name = "Samsung Internet"
+	want.Version = mustParse("4.0.0")
+	want.Security = SecurityUnknown
+	want.Device = DeviceXR
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Device != want.Device {
+		t.Errorf("expected %s, got %s\n", want.Device, got.Device)
+	}
+
+	got = Parse(`Mozilla/5.0 (X11; GNU/Linux) AppleWebKit/537.36 (KHTML, like Gecko) Chromium/79.0.3945.130 Chrome/79.0.3945.130 Safari/537.36 Tesla/2020.16.2.1-e99c70fff409`)
+	want.Type = Browser
+	want.OS = "GNU/Linux"
+	want.OSVersion = semver.Version{}
+	want.Name = "Chromium"
+	want.Version = mustParse("79.0.3945")
+	want.Security = SecurityUnknown
+	want.Device = DeviceCar
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Device != want.Device {
+		t.Errorf("expected %s, got %s\n", want.Device, got.Device)
+	}
+
+	got = Parse(`Mozilla/5.0 (Mobile; LYF/F300B/LYF-F300B-001-01-15-130718-i; Android; rv:48.0) Gecko/48.0 Firefox/48.0 KAIOS/2.5`)
+	want.Type = Browser
+	want.OS = "Firefox OS"
+	want.OSVersion = semver.Version{}
+	want.Name = "Firefox"
+	want.Version = mustParse("48.0.0")
+	want.Security = SecurityUnknown
+	want.Mobile = true
+	want.Device = DeviceFeaturePhone
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Device != want.Device {
+		t.Errorf("expected %s, got %s\n", want.Device, got.Device)
+	}
+
+	got = Parse(`Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Googlebot"
+	want.Version = mustParse("2.1.0")
+	want.Security = SecurityUnknown
+	want.Device = DevicePhone
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Device != want.Device {
+		t.Errorf("expected %s, got %s\n", want.Device, got.Device)
+	}
+
+	got = Parse(`Googlebot/2.1 (+http://www.google.com/bot.html)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Googlebot"
+	want.Version = mustParse("2.1.0")
+	want.Security = SecurityUnknown
+	want.Mobile = false
+	want.Device = DeviceUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Device != want.Device {
+		t.Errorf("expected %s, got %s\n", want.Device, got.Device)
+	}
+}
+
+func TestDeviceModel(t *testing.T) {
+	var got *UserAgent
+	want := &UserAgent{}
+
+	got = Parse(`Mozilla/5.0 (Linux; Android 14; SM-S918B Build/UP1A.231005.007) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/119.0.6045.163 Mobile Safari/537.36`)
+	want.Type = Browser
+	want.OS = "Android"
+	want.OSVersion = mustParse("14.0.0")
+	want.Name = "Chrome"
+	want.Version = mustParse("119.0.6045")
+	want.Security = SecurityUnknown
+	want.Mobile = true
+	want.DeviceVendor = "Samsung"
+	want.DeviceModel = "Galaxy S23 Ultra"
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.DeviceVendor != want.DeviceVendor || got.DeviceModel != want.DeviceModel {
+		t.Errorf("expected %q %q, got %q %q\n", want.DeviceVendor, want.DeviceModel, got.DeviceVendor, got.DeviceModel)
+	}
+
+	got = Parse(`Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/119.0.6045.163 Mobile Safari/537.36`)
+	want.Type = Browser
+	want.OS = "Android"
+	want.OSVersion = mustParse("14.0.0")
+	want.Name = "Chrome"
+	want.Version = mustParse("119.0.6045")
+	want.Security = SecurityUnknown
+	want.DeviceVendor = "Google"
+	want.DeviceModel = "Pixel 8"
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.DeviceVendor != want.DeviceVendor || got.DeviceModel != want.DeviceModel {
+		t.Errorf("expected %q %q, got %q %q\n", want.DeviceVendor, want.DeviceModel, got.DeviceVendor, got.DeviceModel)
+	}
+
+	got = Parse(`Mozilla/5.0 (Linux; U; Android 10; en-US; RMX1911 Build/QKQ1.200209.002) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/78.0.3904.108 UCBrowser/13.4.0.1306 Mobile Safari/537.36`)
+	want.Type = Browser
+	want.OS = "Android"
+	want.OSVersion = mustParse("10.0.0")
+	want.Name = "UC Browser"
+	want.Version = mustParse("13.4.0")
+	want.Security = SecurityStrong
+	want.DeviceVendor = "Realme"
+	want.DeviceModel = "Realme 5"
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.DeviceVendor != want.DeviceVendor || got.DeviceModel != want.DeviceModel {
+		t.Errorf("expected %q %q, got %q %q\n", want.DeviceVendor, want.DeviceModel, got.DeviceVendor, got.DeviceModel)
+	}
+
+	got = Parse(`Mozilla/5.0 (Linux; Android 13; SM-X123) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/119.0.6045.163 Safari/537.36`)
+	want.Type = Browser
+	want.OS = "Android"
+	want.OSVersion = mustParse("13.0.0")
+	want.Name = "Chrome"
+	want.Version = mustParse("119.0.6045")
+	want.Security = SecurityUnknown
+	want.Mobile = false
+	want.Tablet = true
+	want.DeviceVendor = "Samsung"
+	want.DeviceModel = "SM-X123"
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.DeviceVendor != want.DeviceVendor || got.DeviceModel != want.DeviceModel {
+		t.Errorf("expected %q %q, got %q %q\n", want.DeviceVendor, want.DeviceModel, got.DeviceVendor, got.DeviceModel)
+	}
+
+	got = Parse(`Mozilla/5.0 (Linux; Android 5.1.1; Nexus 5 Build/LMY48B; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/43.0.2357.65 Mobile Safari/537.36`)
+	want.Type = Library
+	want.OS = "Android"
+	want.OSVersion = mustParse("5.1.1")
+	want.Name = "WebView"
+	want.Version = mustParse("43.0.2357")
+	want.Security = SecurityUnknown
+	want.Mobile = true
+	want.Tablet = false
+	want.DeviceVendor = "Google"
+	want.DeviceModel = "Nexus 5"
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.DeviceVendor != want.DeviceVendor || got.DeviceModel != want.DeviceModel {
+		t.Errorf("expected %q %q, got %q %q\n", want.DeviceVendor, want.DeviceModel, got.DeviceVendor, got.DeviceModel)
+	}
+
+	got = Parse(`Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 [FBAN/FBIOS;FBDV/iPhone15,2;FBMD/iPhone;FBSN/iOS;FBSV/17.0;FBSS/3;FBID/phone;FBLC/en_US;FBOP/5]`)
+	want.Type = Library
+	want.OS = "iOS"
+	want.OSVersion = mustParse("17.0.0")
+	want.Name = "WebView"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.DeviceVendor = "Apple"
+	want.DeviceModel = "iPhone 14 Pro"
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.DeviceVendor != want.DeviceVendor || got.DeviceModel != want.DeviceModel {
+		t.Errorf("expected %q %q, got %q %q\n", want.DeviceVendor, want.DeviceModel, got.DeviceVendor, got.DeviceModel)
+	}
+
+	got = Parse(`Mozilla/5.0 (iPhone; CPU iPhone OS 16_5 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 Instagram 290.0.0.13.76 (iPhone14,5; iOS 16_5; en_US; en; scale=3.00; 1170x2532; 489887830)`)
+	want.Type = Library
+	want.OS = "iOS"
+	want.OSVersion = mustParse("16.5.0")
+	want.Name = "WebView"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.DeviceVendor = "Apple"
+	want.DeviceModel = "iPhone 13"
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.DeviceVendor != want.DeviceVendor || got.DeviceModel != want.DeviceModel {
+		t.Errorf("expected %q %q, got %q %q\n", want.DeviceVendor, want.DeviceModel, got.DeviceVendor, got.DeviceModel)
+	}
+
+	got = Parse(`Mozilla/5.0 (iPad; CPU OS 16_6 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) EdgiOS/116.0.1938.72 Mobile/15E148 Safari/605.1.15`)
+	want.Type = Browser
+	want.OS = "iOS"
+	want.OSVersion = mustParse("16.6.0")
+	want.Name = "Edge"
+	want.Version = mustParse("116.0.1938")
+	want.Security = SecurityUnknown
+	want.Mobile = false
+	want.Tablet = true
+	want.DeviceVendor = "Apple"
+	want.DeviceModel = "iPad"
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.DeviceVendor != want.DeviceVendor || got.DeviceModel != want.DeviceModel {
+		t.Errorf("expected %q %q, got %q %q\n", want.DeviceVendor, want.DeviceModel, got.DeviceVendor, got.DeviceModel)
+	}
+
+	// reduced UAs don't have a model
+	got = Parse(`Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36`)
+	want.Type = Browser
+	want.OS = "Android"
+	want.OSVersion = semver.Version{}
+	want.Name = "Chrome"
+	want.Version = mustParse("120.0.0")
+	want.Security = SecurityUnknown
+	want.Mobile = true
+	want.Tablet = false
+	want.Reduced = true
+	want.DeviceVendor = ""
+	want.DeviceModel = ""
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.DeviceVendor != want.DeviceVendor || got.DeviceModel != want.DeviceModel {
+		t.Errorf("expected %q %q, got %q %q\n", want.DeviceVendor, want.DeviceModel, got.DeviceVendor, got.DeviceModel)
+	}
+
+	got = Parse(`Mozilla/5.0 (Android 4.4; Mobile; rv:41.0) Gecko/41.0 Firefox/41.0`)
+	want.Type = Browser
+	want.OS = "Android"
+	want.OSVersion = mustParse("4.4.0")
+	want.Name = "Firefox"
+	want.Version = mustParse("41.0.0")
+	want.Security = SecurityUnknown
+	want.Mobile = false
+	want.Reduced = false
+	want.DeviceVendor = ""
+	want.DeviceModel = ""
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.DeviceVendor != want.DeviceVendor || got.DeviceModel != want.DeviceModel {
+		t.Errorf("expected %q %q, got %q %q\n", want.DeviceVendor, want.DeviceModel, got.DeviceVendor, got.DeviceModel)
+	}
+}
+
+func TestOSVersionName(t *testing.T) {
+	var got *UserAgent
+	want := &UserAgent{}
+
+	got = Parse(`Mozilla/5.0 (Windows NT 6.1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/41.0.2228.0 Safari/537.36`)
+	want.Type = Browser
+	want.OS = "Windows"
+	want.OSVersion = mustParse("6.1.0")
+	want.Name = "Chrome"
+	want.Version = mustParse("41.0.2228")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.OSVersionName() != "Windows 7" || got.AndroidAPILevel() != 0 {
+		t.Errorf("expected %q %d, got %q %d\n", "Windows 7", 0, got.OSVersionName(), got.AndroidAPILevel())
+	}
+
+	got = Parse(`Mozilla/5.0 (Windows NT 6.3; Trident/7.0; .NET4.0E; .NET4.0C; rv:11.0) like Gecko`)
+	want.Type = Browser
+	want.OS = "Windows"
+	want.OSVersion = mustParse("6.3.0")
+	want.Name = "MSIE"
+	want.Version = mustParse("11.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.OSVersionName() != "Windows 8.1" || got.AndroidAPILevel() != 0 {
+		t.Errorf("expected %q %d, got %q %d\n", "Windows 8.1", 0, got.OSVersionName(), got.AndroidAPILevel())
+	}
+
+	got = Parse(`Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:120.0) Gecko/20100101 Firefox/120.0`)
+	want.Type = Browser
+	want.OS = "Windows"
+	want.OSVersion = mustParse("10.0.0")
+	want.Name = "Firefox"
+	want.Version = mustParse("120.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.OSVersionName() != "Windows 10/11" || got.AndroidAPILevel() != 0 {
+		t.Errorf("expected %q %d, got %q %d\n", "Windows 10/11", 0, got.OSVersionName(), got.AndroidAPILevel())
+	}
+
+	got = Parse(`Mozilla/5.0 (Macintosh; Intel Mac OS X 10_12_0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/53.0.2785.143 Safari/537.36`)
+	want.Type = Browser
+	want.OS = "Mac OS X"
+	want.OSVersion = mustParse("10.12.0")
+	want.Name = "Chrome"
+	want.Version = mustParse("53.0.2785")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.OSVersionName() != "macOS Sierra" || got.AndroidAPILevel() != 0 {
+		t.Errorf("expected %q %d, got %q %d\n", "macOS Sierra", 0, got.OSVersionName(), got.AndroidAPILevel())
+	}
+
+	got = Parse(`Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Safari/605.1.15`)
+	want.Type = Browser
+	want.OS = "Mac OS X"
+	want.OSVersion = mustParse("10.15.7")
+	want.Name = "Safari"
+	want.Version = mustParse("17.1.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.OSVersionName() != "macOS Catalina or later" || got.AndroidAPILevel() != 0 {
+		t.Errorf("expected %q %d, got %q %d\n", "macOS Catalina or later", 0, got.OSVersionName(), got.AndroidAPILevel())
+	}
+
+	got = Parse(`Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_4) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/13.1 Safari/605.1.15`)
+	want.Type = Browser
+	want.OS = "Mac OS X"
+	want.OSVersion = mustParse("10.15.4")
+	want.Name = "Safari"
+	want.Version = mustParse("13.1.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.OSVersionName() != "macOS Catalina" || got.AndroidAPILevel() != 0 {
+		t.Errorf("expected %q %d, got %q %d\n", "macOS Catalina", 0, got.OSVersionName(), got.AndroidAPILevel())
+	}
+
+	got = Parse(`Mozilla/5.0 (Linux; Android 4.4.3; KFTHWI Build/KTU84M) AppleWebKit/537.36 (KHTML, like Gecko) Silk/44.1.54 like Chrome/44.0.2403.63 Safari/537.36`)
+	want.Type = Browser
+	want.OS = "Android"
+	want.OSVersion = mustParse("4.4.3")
+	want.Name = "Silk"
+	want.Version = mustParse("44.1.54")
+	want.Security = SecurityUnknown
+	want.Tablet = true
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.OSVersionName() != "Android 4.4 KitKat" || got.AndroidAPILevel() != 19 {
+		t.Errorf("expected %q %d, got %q %d\n", "Android 4.4 KitKat", 19, got.OSVersionName(), got.AndroidAPILevel())
+	}
+
+	got = Parse(`Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/119.0.6045.163 Mobile Safari/537.36`)
+	want.Type = Browser
+	want.OS = "Android"
+	want.OSVersion = mustParse("14.0.0")
+	want.Name = "Chrome"
+	want.Version = mustParse("119.0.6045")
+	want.Security = SecurityUnknown
+	want.Mobile = true
+	want.Tablet = false
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.OSVersionName() != "Android 14 Upside Down Cake" || got.AndroidAPILevel() != 34 {
+		t.Errorf("expected %q %d, got %q %d\n", "Android 14 Upside Down Cake", 34, got.OSVersionName(), got.AndroidAPILevel())
+	}
+
+	got = Parse(`Mozilla/5.0 (iPhone; CPU iPhone OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 EdgiOS/119.2151.65 Mobile/15E148 Safari/605.1.15`)
+	want.Type = Browser
+	want.OS = "iOS"
+	want.OSVersion = mustParse("17.1.0")
+	want.Name = "Edge"
+	want.Version = mustParse("119.2151.65")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.OSVersionName() != "iOS 17" || got.AndroidAPILevel() != 0 {
+		t.Errorf("expected %q %d, got %q %d\n", "iOS 17", 0, got.OSVersionName(), got.AndroidAPILevel())
+	}
+
+	got = Parse(`Mozilla/5.0 (iPad; CPU OS 16_6 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) EdgiOS/116.0.1938.72 Mobile/15E148 Safari/605.1.15`)
+	want.Type = Browser
+	want.OS = "iOS"
+	want.OSVersion = mustParse("16.6.0")
+	want.Name = "Edge"
+	want.Version = mustParse("116.0.1938")
+	want.Security = SecurityUnknown
+	want.Mobile = false
+	want.Tablet = true
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.OSVersionName() != "iPadOS 16" || got.AndroidAPILevel() != 0 {
+		t.Errorf("expected %q %d, got %q %d\n", "iPadOS 16", 0, got.OSVersionName(), got.AndroidAPILevel())
+	}
+
+	got = Parse(`Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36`)
+	want.Type = Browser
+	want.OS = "Android"
+	want.OSVersion = semver.Version{}
+	want.Name = "Chrome"
+	want.Version = mustParse("120.0.0")
+	want.Security = SecurityUnknown
+	want.Mobile = true
+	want.Tablet = false
+	want.Reduced = true
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.OSVersionName() != "" || got.AndroidAPILevel() != 0 {
+		t.Errorf("expected %q %d, got %q %d\n", "", 0, got.OSVersionName(), got.AndroidAPILevel())
+	}
+}
+
+func TestArch(t *testing.T) {
+	var got *UserAgent
+	want := &UserAgent{}
+
+	got = Parse(`Mozilla/5.0 (X11; Linux i686; rv:38.0) Gecko/20100101 Firefox/38.0`)
+	want.Type = Browser
+	want.OS = "GNU/Linux"
+	want.OSVersion = semver.Version{}
+	want.Name = "Firefox"
+	want.Version = mustParse("38.0.0")
+	want.Security = SecurityUnknown
+	want.Arch = "x86"
+	want.Bitness = 32
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Arch != want.Arch || got.Bitness != want.Bitness {
+		t.Errorf("expected %q %d, got %q %d\n", want.Arch, want.Bitness, got.Arch, got.Bitness)
+	}
+
+	got = Parse(`Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/41.0.2227.0 Safari/537.36`)
+	want.Type = Browser
+	want.OS = "GNU/Linux"
+	want.OSVersion = semver.Version{}
+	want.Name = "Chrome"
+	want.Version = mustParse("41.0.2227")
+	want.Security = SecurityUnknown
+	want.Arch = "x86"
+	want.Bitness = 64
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Arch != want.Arch || got.Bitness != want.Bitness {
+		t.Errorf("expected %q %d, got %q %d\n", want.Arch, want.Bitness, got.Arch, got.Bitness)
+	}
+
+	got = Parse(`Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36`)
+	want.Type = Browser
+	want.OS = "Windows"
+	want.OSVersion = semver.Version{}
+	want.Name = "Chrome"
+	want.Version = mustParse("120.0.0")
+	want.Security = SecurityUnknown
+	want.Reduced = true
+	want.Arch = ""
+	want.Bitness = 0
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Arch != want.Arch || got.Bitness != want.Bitness {
+		t.Errorf("expected %q %d, got %q %d\n", want.Arch, want.Bitness, got.Arch, got.Bitness)
+	}
+
+	got = Parse(`Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36`)
+	want.Type = Browser
+	want.OS = "GNU/Linux"
+	want.OSVersion = semver.Version{}
+	want.Name = "Chrome"
+	want.Version = mustParse("120.0.0")
+	want.Security = SecurityUnknown
+	want.Reduced = true
+	want.Arch = ""
+	want.Bitness = 0
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Arch != want.Arch || got.Bitness != want.Bitness {
+		t.Errorf("expected %q %d, got %q %d\n", want.Arch, want.Bitness, got.Arch, got.Bitness)
+	}
+
+	got = Parse(`Mozilla/5.0 (Windows NT 6.1; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/28.0.1500.52 Safari/537.36 OPR/15.0.1147.100`)
+	want.Type = Browser
+	want.OS = "Windows"
+	want.OSVersion = mustParse("6.1.0")
+	want.Name = "Opera"
+	want.Version = mustParse("15.0.1147")
+	want.Security = SecurityUnknown
+	want.Reduced = false
+	want.Arch = "x86"
+	want.Bitness = 64
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Arch != want.Arch || got.Bitness != want.Bitness {
+		t.Errorf("expected %q %d, got %q %d\n", want.Arch, want.Bitness, got.Arch, got.Bitness)
+	}
+
+	got = Parse(`Mozilla/5.0 (X11; Linux aarch64; rv:109.0) Gecko/20100101 Firefox/115.0`)
+	want.Type = Browser
+	want.OS = "GNU/Linux"
+	want.OSVersion = semver.Version{}
+	want.Name = "Firefox"
+	want.Version = mustParse("115.0.0")
+	want.Security = SecurityUnknown
+	want.Arch = "arm"
+	want.Bitness = 64
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Arch != want.Arch || got.Bitness != want.Bitness {
+		t.Errorf("expected %q %d, got %q %d\n", want.Arch, want.Bitness, got.Arch, got.Bitness)
+	}
+
+	got = Parse(`Mozilla/5.0 (X11; Linux armv7l) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/92.0.4515.98 Safari/537.36`)
+	want.Type = Browser
+	want.OS = "GNU/Linux"
+	want.OSVersion = semver.Version{}
+	want.Name = "Chrome"
+	want.Version = mustParse("92.0.4515")
+	want.Security = SecurityUnknown
+	want.Arch = "arm"
+	want.Bitness = 32
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Arch != want.Arch || got.Bitness != want.Bitness {
+		t.Errorf("expected %q %d, got %q %d\n", want.Arch, want.Bitness, got.Arch, got.Bitness)
+	}
+
+	got = Parse(`Opera/9.30 (Macintosh; PPC Mac OS X; U; ja)`)
+	want.Type = Browser
+	want.OS = "Mac OS X"
+	want.OSVersion = semver.Version{}
+	want.Name = "Opera"
+	want.Version = mustParse("9.30.0")
+	want.Security = SecurityStrong
+	want.Arch = "ppc"
+	want.Bitness = 32
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Arch != want.Arch || got.Bitness != want.Bitness {
+		t.Errorf("expected %q %d, got %q %d\n", want.Arch, want.Bitness, got.Arch, got.Bitness)
+	}
+
+	got = Parse(`Mozilla/5.0 (Macintosh; Intel Mac OS X 10_12_0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/53.0.2785.143 Safari/537.36`)
+	want.Type = Browser
+	want.OS = "Mac OS X"
+	want.OSVersion = mustParse("10.12.0")
+	want.Name = "Chrome"
+	want.Version = mustParse("53.0.2785")
+	want.Security = SecurityUnknown
+	want.Arch = ""
+	want.Bitness = 0
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Arch != want.Arch || got.Bitness != want.Bitness {
+		t.Errorf("expected %q %d, got %q %d\n", want.Arch, want.Bitness, got.Arch, got.Bitness)
+	}
+
+	got = Parse(`Mozilla/5.0 (Windows NT 6.1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/41.0.2228.0 Safari/537.36`)
+	want.Type = Browser
+	want.OS = "Windows"
+	want.OSVersion = mustParse("6.1.0")
+	want.Name = "Chrome"
+	want.Version = mustParse("41.0.2228")
+	want.Security = SecurityUnknown
+	want.Arch = ""
+	want.Bitness = 0
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Arch != want.Arch || got.Bitness != want.Bitness {
+		t.Errorf("expected %q %d, got %q %d\n", want.Arch, want.Bitness, got.Arch, got.Bitness)
+	}
+}
+
+func TestDistribution(t *testing.T) {
+	var got *UserAgent
+	want := &UserAgent{}
+
+	got = Parse(`Mozilla/5.0 (X11; Ubuntu; Linux x86_64; rv:109.0) Gecko/20100101 Firefox/119.0`)
+	want.Type = Browser
+	want.OS = "GNU/Linux"
+	want.OSVersion = semver.Version{}
+	want.Name = "Firefox"
+	want.Version = mustParse("119.0.0")
+	want.Security = SecurityUnknown
+	want.Distribution = "Ubuntu"
+	want.DistributionVersion = ""
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Distribution != want.Distribution || got.DistributionVersion != want.DistributionVersion {
+		t.Errorf("expected %q %q, got %q %q\n", want.Distribution, want.DistributionVersion, got.Distribution, got.DistributionVersion)
+	}
+
+	got = Parse(`Mozilla/5.0 (X11; Fedora; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/48.0.2564.109 Safari/537.36`)
+	want.Type = Browser
+	want.OS = "GNU/Linux"
+	want.OSVersion = semver.Version{}
+	want.Name = "Chrome"
+	want.Version = mustParse("48.0.2564")
+	want.Security = SecurityUnknown
+	want.Distribution = "Fedora"
+	want.DistributionVersion = ""
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Distribution != want.Distribution || got.DistributionVersion != want.DistributionVersion {
+		t.Errorf("expected %q %q, got %q %q\n", want.Distribution, want.DistributionVersion, got.Distribution, got.DistributionVersion)
+	}
+
+	got = Parse(`Mozilla/5.0 (X11; U; Linux i686; en-US; rv:1.9.2.3) Gecko/20100403 Fedora/3.6.3-4.fc13 Firefox/3.6.3`)
+	want.Type = Browser
+	want.OS = "GNU/Linux"
+	want.OSVersion = semver.Version{}
+	want.Name = "Fedora"
+	want.Version = mustParse("3.6.3-4.fc13")
+	want.Security = SecurityStrong
+	want.Distribution = "Fedora"
+	want.DistributionVersion = "3.6.3-4.fc13"
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Distribution != want.Distribution || got.DistributionVersion != want.DistributionVersion {
+		t.Errorf("expected %q %q, got %q %q\n", want.Distribution, want.DistributionVersion, got.Distribution, got.DistributionVersion)
+	}
+
+	got = Parse(`Mozilla/5.0 (X11; Linux x86_64; rv:109.0) Gecko/20100101 Firefox/115.0 Fedora/38`)
+	want.Type = Browser
+	want.OS = "GNU/Linux"
+	want.OSVersion = semver.Version{}
+	want.Name = "Firefox"
+	want.Version = mustParse("115.0.0")
+	want.Security = SecurityUnknown
+	want.Distribution = "Fedora"
+	want.DistributionVersion = "38"
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Distribution != want.Distribution || got.DistributionVersion != want.DistributionVersion {
+		t.Errorf("expected %q %q, got %q %q\n", want.Distribution, want.DistributionVersion, got.Distribution, got.DistributionVersion)
+	}
+
+	got = Parse(`Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/41.0.2227.0 Safari/537.36`)
+	want.Type = Browser
+	want.OS = "GNU/Linux"
+	want.OSVersion = semver.Version{}
+	want.Name = "Chrome"
+	want.Version = mustParse("41.0.2227")
+	want.Security = SecurityUnknown
+	want.Distribution = ""
+	want.DistributionVersion = ""
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Distribution != want.Distribution || got.DistributionVersion != want.DistributionVersion {
+		t.Errorf("expected %q %q, got %q %q\n", want.Distribution, want.DistributionVersion, got.Distribution, got.DistributionVersion)
+	}
+
+	got = Parse(`Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/119.0.6045.105 Safari/537.36 Minty/1.0`)
+	want.Type = Browser
+	want.OS = "GNU/Linux"
+	want.OSVersion = semver.Version{}
+	want.Name = "Chrome"
+	want.Version = mustParse("119.0.6045")
+	want.Security = SecurityUnknown
+	want.Distribution = ""
+	want.DistributionVersion = ""
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Distribution != want.Distribution || got.DistributionVersion != want.DistributionVersion {
+		t.Errorf("expected %q %q, got %q %q\n", want.Distribution, want.DistributionVersion, got.Distribution, got.DistributionVersion)
+	}
+
+	got = Parse(`Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/119.0.6045.105 Safari/537.36 Mint`)
+	want.Type = Browser
+	want.OS = "GNU/Linux"
+	want.OSVersion = semver.Version{}
+	want.Name = "Chrome"
+	want.Version = mustParse("119.0.6045")
+	want.Security = SecurityUnknown
+	want.Distribution = ""
+	want.DistributionVersion = ""
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Distribution != want.Distribution || got.DistributionVersion != want.DistributionVersion {
+		t.Errorf("expected %q %q, got %q %q\n", want.Distribution, want.DistributionVersion, got.Distribution, got.DistributionVersion)
+	}
+
+	got = Parse(`Mozilla/5.0 (Windows NT 6.1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/41.0.2228.0 Safari/537.36`)
+	want.Type = Browser
+	want.OS = "Windows"
+	want.OSVersion = mustParse("6.1.0")
+	want.Name = "Chrome"
+	want.Version = mustParse("41.0.2228")
+	want.Security = SecurityUnknown
+	want.Distribution = ""
+	want.DistributionVersion = ""
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Distribution != want.Distribution || got.DistributionVersion != want.DistributionVersion {
+		t.Errorf("expected %q %q, got %q %q\n", want.Distribution, want.DistributionVersion, got.Distribution, got.DistributionVersion)
+	}
+}
+
+func TestGeneric(t *testing.T) {
+	var got *UserAgent
+	want := &UserAgent{}
+
+	got = Parse(`Dillo/0.8.6-i18n-misc`)
+	want.Type = Browser
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Dillo"
+	want.Version = mustParse("0.8.6-i18n-misc")
+	want.Security = SecurityUnknown
+	want.URL = u("http://www.dillo.org/")
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	// Versions with only the major number
+	got = Parse(`Dillo/3`)
+	want.Version = mustParse("3.0.0")
+	want.URL = u("http://www.dillo.org/")
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	// but not empty or non numeric ones
+	got = Parse(`Firefox/ x`)
+	if got != nil {
+		t.Errorf("expected nil, got %+v\n", got)
+	}
+	got = Parse(`Dillo/x`)
+	if got != nil {
+		t.Errorf("expected nil, got %+v\n", got)
+	}
+}
+
+func TestPhantomJS(t *testing.T) {
+	var got *UserAgent
+	want := &UserAgent{}
+	want.Mobile = false
+	want.Tablet = false
+
+	got = Parse(`Mozilla/5.0 (Macintosh; Intel Mac OS X) AppleWebKit/538.1 (KHTML, like Gecko) PhantomJS/2.0.0 Safari/538.1`)
+	want.Type = Library
+	want.OS = "Mac OS X"
+	want.OSVersion = semver.Version{}
+	want.Name = "PhantomJS"
+	want.Version = mustParse("2.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`Mozilla/5.0 (Macintosh; Intel Mac OS X) AppleWebKit/534.34 (KHTML, like Gecko) PhantomJS/1.9.0 (development) Safari/534.34`)
+	want.Type = Library
+	want.OS = "Mac OS X"
+	want.OSVersion = semver.Version{}
+	want.Name = "PhantomJS"
+	want.Version = mustParse("1.9.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`Mozilla/5.0 (Unknown; Linux x86_64) AppleWebKit/538.1 (KHTML, like Gecko) PhantomJS/2.1.1 Safari/538.1`)
+	want.Type = Library
+	want.OS = "GNU/Linux"
+	want.OSVersion = semver.Version{}
+	want.Name = "PhantomJS"
+	want.Version = mustParse("2.1.1")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+}
+
+func TestOpera(t *testing.T) {
+	var got *UserAgent
+	want := &UserAgent{}
+	want.Mobile = false
+	want.Tablet = false
+
+	got = Parse(`Opera/4.02 (Windows 98; U) [de]`)
+	want.Type = Browser
+	want.OS = "Windows"
+	want.OSVersion = semver.Version{}
+	want.Name = "Opera"
+	want.Version = mustParse("4.2.0")
+	want.Security = SecurityStrong
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`Opera/9.30 (Macintosh; PPC Mac OS X; U; ja)`)
+	want.Type = Browser
+	want.OS = "Mac OS X"
+	want.OSVersion = semver.Version{}
+	want.Name = "Opera"
+	want.Version = mustParse("9.30.0")
+	want.Security = SecurityStrong
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`Opera/7.52 (FreeBSD 4.7-RELEASE i386; U) [fr]`)
+	want.Type = Browser
+	want.OS = "FreeBSD"
+	want.OSVersion = semver.Version{}
+	want.Name = "Opera"
+	want.Version = mustParse("7.52.0")
+	want.Security = SecurityStrong
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`Opera/9.80 (Windows NT 6.1; U; en) Presto/2.10.229 Version/11.61`)
+	want.Type = Browser
+	want.OS = "Windows"
+	want.OSVersion = mustParse("6.1.0")
+	want.Name = "Opera"
+	want.Version = mustParse("11.61.0")
+	want.Security = SecurityStrong
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`Opera/9.80 (Linux mips; U; HbbTV/1.1.1 (; TechniSat; DigiPal ISIO HD; 2.70.0.5; 57.0-6; ); CE-HTML/1.0 (); MB_BP/1.0 (TechniSat; DigiPal ISIO HD; ); TechniSat DigiPal ISIO HD BCM3 STB; de) Presto/2.12.407 Version/12.51`)
+	want.Type = Browser
+	want.OS = "GNU/Linux"
+	want.OSVersion = semver.Version{}
+	want.Name = "Opera"
+	want.Version = mustParse("12.51.0")
+	want.Security = SecurityStrong
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`Mozilla/4.0 (compatible; MSIE 8.0; Windows NT 6.0; zh-tw) Opera 11.00`)
+	want.Type = Browser
+	want.OS = "Windows"
+	want.OSVersion = mustParse("6.0.0")
+	want.Name = "Opera"
+	want.Version = mustParse("11.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`Mozilla/5.0 (Windows NT 6.1; U; nl; rv:1.9.1.6) Gecko/20091201 Firefox/3.5.6 Opera 11.01`)
+	want.Type = Browser
+	want.OS = "Windows"
+	want.OSVersion = mustParse("6.1.0")
+	want.Name = "Opera"
+	want.Version = mustParse("11.1.0")
+	want.Security = SecurityStrong
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`Mozilla/5.0 (Windows NT 6.1; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/28.0.1500.52 Safari/537.36 OPR/15.0.1147.100`)
+	want.Type = Browser
+	want.OS = "Windows"
+	want.OSVersion = mustParse("6.1.0")
+	want.Name = "Opera"
+	want.Version = mustParse("15.0.1147")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`Mozilla/5.0 (Linux; Android 4.0.4; Galaxy Nexus Build/IMM76B) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/28.0.1500.52 Mobile Safari/537.36 OPR/15.0.1147.100`)
+	want.Type = Browser
+	want.OS = "Android"
+	want.OSVersion = mustParse("4.0.4")
+	want.Name = "Opera"
+	want.Version = mustParse("15.0.1147")
+	want.Security = SecurityUnknown
+	want.Mobile = true
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+}
+
+func TestGoogleBot(t *testing.T) {
+	var got *UserAgent
+	want := &UserAgent{}
+	want.Mobile = false
+	want.Tablet = false
+
+	got = Parse(`Googlebot/2.1 (+http://www.google.com/bot.html)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Googlebot"
+	want.Version = mustParse("2.1.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Googlebot"
+	want.Version = mustParse("2.1")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko; compatible; Googlebot/2.1; +http://www.google.com/bot.html) Chrome/W.X.Y.Z Safari/537.36`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Googlebot"
+	want.Version = mustParse("2.1")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko; compatible; Googlebot/2.1; +http://www.google.com/bot.html) Chrome/120.0.6099.129 Safari/537.36`)
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`Googlebot-News`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Googlebot News"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`Googlebot-Image/1.0`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Googlebot Images"
+	want.Version = mustParse("1.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`Googlebot-Video/1.0`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Googlebot Video"
+	want.Version = mustParse("1.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`Mediapartners-Google`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Google AdSense"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`AdsBot-Google (+http://www.google.com/adsbot.html)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Google AdsBot"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`AdsBot-Google-Mobile-Apps`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Google AdsBot"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`Mozilla/5.0 (Linux; Android 6.0.1; Nexus 5X Build/MMB29P) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/41.0.2272.96 Mobile Safari/537.36 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)`)
+	want.Type = Crawler
+	want.Mobile = true
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Googlebot"
+	want.Version = mustParse("2.1")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+}
+
+func TestLinkChecker(t *testing.T) {
+	var got *UserAgent
+	want := &UserAgent{}
+
+	got = Parse(`W3C-checklink/4.81 libwww-perl/6.04`)
+	want.Type = LinkChecker
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "W3C Link Checker"
+	want.Version = mustParse("4.81.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; LinkChecker/9.3; +http://wummel.github.io/linkchecker/)`)
+	want.Type = LinkChecker
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "LinkChecker"
+	want.Version = mustParse("9.3.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`LinkChecker/10.2.1 (+https://linkchecker.github.io/linkchecker/)`)
+	want.Type = LinkChecker
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "LinkChecker"
+	want.Version = mustParse("10.2.1")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`Xenu Link Sleuth/1.3.8`)
+	want.Type = LinkChecker
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Xenu Link Sleuth"
+	want.Version = mustParse("1.3.8")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`lychee/0.13.0`)
+	want.Type = LinkChecker
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "lychee"
+	want.Version = mustParse("0.13.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	got = Parse(`linkchecker-go/1.0`)
+	want.Type = LinkChecker
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "linkchecker-go"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+
+	if ua := Parse(`lychee/0.13.0`); ua.URL == nil {
+		t.Errorf("expected URL for lychee")
+	}
+}
+
+func TestValidator(t *testing.T) {
+	var got *UserAgent
+	want := &UserAgent{}
+
+	got = Parse(`W3C_Validator/1.3 http://validator.w3.org/services`)
+	want.Type = Validator
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "W3C Markup Validator"
+	want.Version = mustParse("1.3.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Validator.nu/LV http://validator.w3.org/services`)
+	want.Type = Validator
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Nu Html Checker"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`W3C_CSS_Validator_JFouffa/2.0 (See <http://validator.w3.org/services>)`)
+	want.Type = Validator
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "W3C CSS Validator"
+	want.Version = mustParse("2.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`W3C-mobileOK/DDC-1.0 (see http://www.w3.org/2006/07/mobileok-ddc)`)
+	want.Type = Validator
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "W3C mobileOK Checker"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`FeedValidator/1.3`)
+	want.Type = Validator
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Feed Validator"
+	want.Version = mustParse("1.3.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; Google-Structured-Data-Testing-Tool +https://search.google.com/structured-data/testing-tool)`)
+	want.Type = Validator
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Google Structured Data Testing Tool"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; Google-InspectionTool/1.0;)`)
+	want.Type = Validator
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Google Inspection Tool"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+}
+
+func TestGoogleInspectionTool(t *testing.T) {
+	got := Parse(`Mozilla/5.0 (Linux; Android 6.0.1; Nexus 5X Build/MMB29P) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36 (compatible; Google-InspectionTool/1.0;)`)
+	if got == nil || got.Type != Validator || got.Name != "Google Inspection Tool" || !got.Mobile {
+		t.Fatalf("expected mobile Google Inspection Tool validator, got %v", got)
+	}
+	if got.Operator != "Google" || got.Purpose != PurposeUserFetch {
+		t.Errorf("expected Google user fetch, got %s %s", got.Operator, got.Purpose)
+	}
+	if info, ok := got.Crawler(); !ok || info.RobotsToken != "Google-InspectionTool" {
+		t.Errorf("expected robots token Google-InspectionTool, got %v %v", info, ok)
+	}
+}
+
+func TestFeedReader(t *testing.T) {
+	var got *UserAgent
+	want := &UserAgent{}
+
+	got = Parse(`Feedly/1.0 (+http://www.feedly.com/fetcher.html; 1234 subscribers)`)
+	want.Type = FeedReader
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Feedly"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	want.Subscribers = 1234
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Subscribers != want.Subscribers {
+		t.Errorf("expected %d, got %d\n", want.Subscribers, got.Subscribers)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; inoreader.com; 3 subscribers)`)
+	want.Type = FeedReader
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Inoreader"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Subscribers = 3
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Subscribers != want.Subscribers {
+		t.Errorf("expected %d, got %d\n", want.Subscribers, got.Subscribers)
+	}
+
+	got = Parse(`NewsBlur Feed Fetcher - 5 subscribers - https://www.newsblur.com/site/1234/example (Mozilla/5.0 (Macintosh; Intel Mac OS X 10_14_3) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/72.0.3626.121 Safari/537.36)`)
+	want.Type = FeedReader
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "NewsBlur"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Subscribers = 5
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Subscribers != want.Subscribers {
+		t.Errorf("expected %d, got %d\n", want.Subscribers, got.Subscribers)
+	}
+
+	got = Parse(`Feedbin feed-id:1234 - 1 subscriber`)
+	want.Type = FeedReader
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Feedbin"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Subscribers = 1
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Subscribers != want.Subscribers {
+		t.Errorf("expected %d, got %d\n", want.Subscribers, got.Subscribers)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; theoldreader.com; 7 subscribers; feed-id=abc)`)
+	want.Type = FeedReader
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "The Old Reader"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Subscribers = 7
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Subscribers != want.Subscribers {
+		t.Errorf("expected %d, got %d\n", want.Subscribers, got.Subscribers)
+	}
+
+	got = Parse(`Tiny Tiny RSS/22.08 (Unsupported) (https://tt-rss.org/)`)
+	want.Type = FeedReader
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Tiny Tiny RSS"
+	want.Version = mustParse("22.8.0")
+	want.Security = SecurityUnknown
+	want.Subscribers = 0
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Subscribers != want.Subscribers {
+		t.Errorf("expected %d, got %d\n", want.Subscribers, got.Subscribers)
+	}
+
+	got = Parse(`FreshRSS/1.21.0 (Linux; https://freshrss.org)`)
+	want.Type = FeedReader
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "FreshRSS"
+	want.Version = mustParse("1.21.0")
+	want.Security = SecurityUnknown
+	want.Subscribers = 0
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Subscribers != want.Subscribers {
+		t.Errorf("expected %d, got %d\n", want.Subscribers, got.Subscribers)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; Miniflux/2.0.50; +https://miniflux.app)`)
+	want.Type = FeedReader
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Miniflux"
+	want.Version = mustParse("2.0.50")
+	want.Security = SecurityUnknown
+	want.Subscribers = 0
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Subscribers != want.Subscribers {
+		t.Errorf("expected %d, got %d\n", want.Subscribers, got.Subscribers)
+	}
+
+	got = Parse(`Feedfetcher-Google; (+http://www.google.com/feedfetcher.html; 4 subscribers; feed-id=1234)`)
+	want.Type = FeedReader
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Google Feedfetcher"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Subscribers = 4
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Subscribers != want.Subscribers {
+		t.Errorf("expected %d, got %d\n", want.Subscribers, got.Subscribers)
+	}
+}
+
+func TestLibrary(t *testing.T) {
+	var got *UserAgent
+	want := &UserAgent{}
+
+	got = Parse(`curl/8.4.0`)
+	want.Type = Library
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "curl"
+	want.Version = mustParse("8.4.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Wget/1.21`)
+	want.Type = Library
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Wget"
+	want.Version = mustParse("1.21.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Wget/1.21.4 (linux-gnu)`)
+	want.Type = Library
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Wget"
+	want.Version = mustParse("1.21.4")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`python-requests/2.31.0`)
+	want.Type = Library
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "python-requests"
+	want.Version = mustParse("2.31.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Go-http-client/2.0`)
+	want.Type = Library
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Go-http-client"
+	want.Version = mustParse("2.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`okhttp/4.12.0`)
+	want.Type = Library
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "okhttp"
+	want.Version = mustParse("4.12.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`axios/1.6.2`)
+	want.Type = Library
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "axios"
+	want.Version = mustParse("1.6.2")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`node-fetch/1.0 (+https://github.com/bitinn/node-fetch)`)
+	want.Type = Library
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "node-fetch"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Apache-HttpClient/4.5.13 (Java/17.0.2)`)
+	want.Type = Library
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Apache-HttpClient"
+	want.Version = mustParse("4.5.13")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Java/17.0.2`)
+	want.Type = Library
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Java"
+	want.Version = mustParse("17.0.2")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`GuzzleHttp/7`)
+	want.Type = Library
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "GuzzleHttp"
+	want.Version = mustParse("7.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`libwww-perl/6.72`)
+	want.Type = Library
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "libwww-perl"
+	want.Version = mustParse("6.72.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Python/3.11 aiohttp/3.9.1`)
+	want.Type = Library
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "aiohttp"
+	want.Version = mustParse("3.9.1")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+}
+
+func TestSearchCrawler(t *testing.T) {
+	var got *UserAgent
+	want := &UserAgent{}
+
+	got = Parse(`Mozilla/5.0 (compatible; bingbot/2.0; +http://www.bing.com/bingbot.htm)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Bingbot"
+	want.Version = mustParse("2.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (iPhone; CPU iPhone OS 7_0 like Mac OS X) AppleWebKit/537.51.1 (KHTML, like Gecko) Version/7.0 Mobile/11A465 Safari/9537.53 (compatible; bingbot/2.0; +http://www.bing.com/bingbot.htm)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Bingbot"
+	want.Version = mustParse("2.0.0")
+	want.Security = SecurityUnknown
+	want.Mobile = true
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (Windows NT 6.1; WOW64) AppleWebKit/534+ (KHTML, like Gecko) BingPreview/1.0b`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "BingPreview"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Mobile = false
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`msnbot/2.0b (+http://search.msn.com/msnbot.htm)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "MSNBot"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`DuckDuckBot/1.1; (+http://duckduckgo.com/duckduckbot.html)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "DuckDuckBot"
+	want.Version = mustParse("1.1.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; YandexBot/3.0; +http://yandex.com/bots)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "YandexBot"
+	want.Version = mustParse("3.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; YandexImages/3.0; +http://yandex.com/bots)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "YandexImages"
+	want.Version = mustParse("3.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (iPhone; CPU iPhone OS 15_4 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/15.4 Mobile/15E148 Safari/604.1 (compatible; YandexMobileBot/3.0; +http://yandex.com/bots)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "YandexMobileBot"
+	want.Version = mustParse("3.0.0")
+	want.Security = SecurityUnknown
+	want.Mobile = true
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; Baiduspider/2.0; +http://www.baidu.com/search/spider.html)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Baiduspider"
+	want.Version = mustParse("2.0.0")
+	want.Security = SecurityUnknown
+	want.Mobile = false
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_5) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/13.1.1 Safari/605.1.15 (Applebot/0.1; +http://www.apple.com/go/applebot)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Applebot"
+	want.Version = mustParse("0.1.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; Yahoo! Slurp; http://help.yahoo.com/help/us/ysearch/slurp)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Yahoo! Slurp"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Sogou web spider/4.0(+http://www.sogou.com/docs/help/webmasters.htm#07)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Sogou web spider"
+	want.Version = mustParse("4.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; SeznamBot/4.0; +https://o-seznam.cz/napoveda/vyhledavani/en/seznambot-crawler/)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "SeznamBot"
+	want.Version = mustParse("4.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; Yeti/1.1; +https://naver.me/spd)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Yeti"
+	want.Version = mustParse("1.1.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; Qwantbot/1.0_4193332; +https://help.qwant.com/bot/)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Qwantbot"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (Linux; Android 7.0;) AppleWebKit/537.36 (KHTML, like Gecko) Mobile Safari/537.36 (compatible; PetalBot;+https://webmaster.petalsearch.com/site/petalbot)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "PetalBot"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Mobile = true
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; Exabot/3.0; +http://www.exabot.com/go/robot)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Exabot"
+	want.Version = mustParse("3.0.0")
+	want.Security = SecurityUnknown
+	want.Mobile = false
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; coccocbot-web/1.0; +http://help.coccoc.com/searchengine)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "coccocbot"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+}
+
+func TestAICrawler(t *testing.T) {
+	var got *UserAgent
+	want := &UserAgent{}
+
+	got = Parse(`Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko; compatible; GPTBot/1.1; +https://openai.com/gptbot)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "GPTBot"
+	want.Version = mustParse("1.1.0")
+	want.Security = SecurityUnknown
+	want.Purpose = PurposeTraining
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Purpose != want.Purpose {
+		t.Errorf("expected %s, got %s\n", want.Purpose, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko); compatible; ChatGPT-User/1.0; +https://openai.com/bot`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "ChatGPT-User"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	want.Purpose = PurposeUserFetch
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Purpose != want.Purpose {
+		t.Errorf("expected %s, got %s\n", want.Purpose, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko); compatible; OAI-SearchBot/1.0; +https://openai.com/searchbot`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "OAI-SearchBot"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	want.Purpose = PurposeSearch
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Purpose != want.Purpose {
+		t.Errorf("expected %s, got %s\n", want.Purpose, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko; compatible; ClaudeBot/1.0; +claudebot@anthropic.com)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "ClaudeBot"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	want.Purpose = PurposeTraining
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Purpose != want.Purpose {
+		t.Errorf("expected %s, got %s\n", want.Purpose, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko; compatible; Claude-User/1.0; +Claude-User@anthropic.com)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Claude-User"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	want.Purpose = PurposeUserFetch
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Purpose != want.Purpose {
+		t.Errorf("expected %s, got %s\n", want.Purpose, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko; compatible; PerplexityBot/1.0; +https://perplexity.ai/perplexitybot)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "PerplexityBot"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	want.Purpose = PurposeSearch
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Purpose != want.Purpose {
+		t.Errorf("expected %s, got %s\n", want.Purpose, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko; compatible; Perplexity-User/1.0; +https://perplexity.ai/perplexity-user)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Perplexity-User"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	want.Purpose = PurposeUserFetch
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Purpose != want.Purpose {
+		t.Errorf("expected %s, got %s\n", want.Purpose, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`CCBot/2.0 (https://commoncrawl.org/faq/)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "CCBot"
+	want.Version = mustParse("2.0.0")
+	want.Security = SecurityUnknown
+	want.Purpose = PurposeTraining
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Purpose != want.Purpose {
+		t.Errorf("expected %s, got %s\n", want.Purpose, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (Linux; Android 5.0) AppleWebKit/537.36 (KHTML, like Gecko) Mobile Safari/537.36 (compatible; Bytespider; spider-feedback@bytedance.com)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Bytespider"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Purpose = PurposeTraining
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Purpose != want.Purpose {
+		t.Errorf("expected %s, got %s\n", want.Purpose, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (Macintosh; Intel Mac OS X 10_10_1) AppleWebKit/600.2.5 (KHTML, like Gecko) Version/8.0.2 Safari/600.2.5 (Amazonbot/0.1; +https://developer.amazon.com/support/amazonbot)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Amazonbot"
+	want.Version = mustParse("0.1.0")
+	want.Security = SecurityUnknown
+	want.Purpose = PurposeTraining
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Purpose != want.Purpose {
+		t.Errorf("expected %s, got %s\n", want.Purpose, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`meta-externalagent/1.1 (+https://developers.facebook.com/docs/sharing/webmasters/crawler)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Meta-ExternalAgent"
+	want.Version = mustParse("1.1.0")
+	want.Security = SecurityUnknown
+	want.Purpose = PurposeTraining
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Purpose != want.Purpose {
+		t.Errorf("expected %s, got %s\n", want.Purpose, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; Diffbot/0.1; +http://www.diffbot.com)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Diffbot"
+	want.Version = mustParse("0.1.0")
+	want.Security = SecurityUnknown
+	want.Purpose = PurposeTraining
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Purpose != want.Purpose {
+		t.Errorf("expected %s, got %s\n", want.Purpose, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; bingbot/2.0; +http://www.bing.com/bingbot.htm)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Bingbot"
+	want.Version = mustParse("2.0.0")
+	want.Security = SecurityUnknown
+	want.Purpose = PurposeSearch
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Purpose != want.Purpose {
+		t.Errorf("expected %s, got %s\n", want.Purpose, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Googlebot/2.1 (+http://www.google.com/bot.html)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Googlebot"
+	want.Version = mustParse("2.1.0")
+	want.Security = SecurityUnknown
+	want.Purpose = PurposeSearch
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Purpose != want.Purpose {
+		t.Errorf("expected %s, got %s\n", want.Purpose, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+}
+
+func TestGoogleCrawlers(t *testing.T) {
+	var got *UserAgent
+	want := &UserAgent{}
+
+	got = Parse(`Mozilla/5.0 (Linux; Android 6.0.1; Nexus 5X Build/MMB29P) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/99.0.4844.84 Mobile Safari/537.36 (compatible; AdsBot-Google-Mobile; +http://www.google.com/mobile/adsbot.html)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Google AdsBot Mobile"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Mobile = true
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (iPhone; CPU iPhone OS 14_7_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/14.1.2 Mobile/15E148 Safari/604.1 (compatible; AdsBot-Google-Mobile; +http://www.google.com/mobile/adsbot.html)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Google AdsBot Mobile"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (X11; Linux x86_64; Storebot-Google/1.0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/79.0.3945.88 Safari/537.36`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Google Storebot"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	want.Mobile = false
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (Linux; Android 8.0; Pixel 2 Build/OPD3.170816.012; Storebot-Google/1.0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/81.0.4044.138 Mobile Safari/537.36`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Google Storebot"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	want.Mobile = true
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`GoogleOther`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "GoogleOther"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Mobile = false
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (Linux; Android 6.0.1; Nexus 5X Build/MMB29P) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36 (compatible; GoogleOther)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "GoogleOther"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Mobile = true
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`GoogleOther-Image/1.0`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "GoogleOther Images"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	want.Mobile = false
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`GoogleOther-Video/1.0`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "GoogleOther Video"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Googlebot-Video/1.0`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Googlebot Video"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`APIs-Google (+https://developers.google.com/webmasters/APIs-Google.html)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "APIs-Google"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko; Google-Read-Aloud; +https://support.google.com/webmasters/answer/1061943) Chrome/84.0.4147.105 Safari/537.36`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Google Read Aloud"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (Linux; Android 7.0; SM-G930V Build/NRD90M) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/59.0.3071.125 Mobile Safari/537.36 (compatible; Google-Read-Aloud; +https://support.google.com/webmasters/answer/1061943)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Google Read Aloud"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Mobile = true
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/49.0.2623.75 Safari/537.36 Google Favicon`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Google Favicon"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Mobile = false
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; Google-Site-Verification/1.0)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Google Site Verifier"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Google-Safety`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Google Safety"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (Linux; Android 11; Pixel 2; DuplexWeb-Google/1.0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/86.0.4240.193 Mobile Safari/537.36`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Google Duplex"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	want.Mobile = true
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`FeedFetcher-Google; (+http://www.google.com/feedfetcher.html)`)
+	if got == nil || got.Type != FeedReader || got.Name != "Google Feedfetcher" {
+		t.Errorf("expected Google Feedfetcher, got %+v", got)
+	}
+}
+
+func TestPreviewer(t *testing.T) {
+	var got *UserAgent
+	want := &UserAgent{}
+
+	got = Parse(`facebookexternalhit/1.1 (+http://www.facebook.com/externalhit_uatext.php)`)
+	want.Type = Previewer
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "facebookexternalhit"
+	want.Version = mustParse("1.1.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Facebot`)
+	want.Type = Previewer
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Facebot"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Twitterbot/1.0`)
+	want.Type = Previewer
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Twitterbot"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`LinkedInBot/1.0 (compatible; Mozilla/5.0; Apache-HttpClient +http://www.linkedin.com)`)
+	want.Type = Previewer
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "LinkedInBot"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Slackbot-LinkExpanding 1.0 (+https://api.slack.com/robots)`)
+	want.Type = Previewer
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Slackbot"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; Discordbot/2.0; +https://discordapp.com)`)
+	want.Type = Previewer
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Discordbot"
+	want.Version = mustParse("2.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`TelegramBot (like TwitterBot)`)
+	want.Type = Previewer
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "TelegramBot"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`WhatsApp/2.23.20.0 A`)
+	want.Type = Previewer
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "WhatsApp"
+	want.Version = mustParse("2.23.20")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; Pinterestbot/1.0; +http://www.pinterest.com/bot.html)`)
+	want.Type = Previewer
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Pinterestbot"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Pinterest/0.2 (+https://www.pinterest.com/bot.html)`)
+	want.Type = Previewer
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Pinterestbot"
+	want.Version = mustParse("0.2.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; redditbot/1.0; +http://www.reddit.com/feedback)`)
+	want.Type = Previewer
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "redditbot"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; Embedly/0.2; +http://support.embed.ly/)`)
+	want.Type = Previewer
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Embedly"
+	want.Version = mustParse("0.2.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Iframely/1.3.1 (+https://iframely.com/docs/about) Atlassian`)
+	want.Type = Previewer
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Iframely"
+	want.Version = mustParse("1.3.1")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`http.rb/5.1.1 (Mastodon/4.2.0; +https://mastodon.social/)`)
+	want.Type = Previewer
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Mastodon"
+	want.Version = mustParse("4.2.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (Windows NT 6.1; WOW64) SkypeUriPreview Preview/0.5 skype-url-preview@microsoft.com`)
+	want.Type = Previewer
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Skype"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	// Pinterest's in-app browser isn't Pinterestbot
+	got = Parse(`Mozilla/5.0 (Linux; Android 13; Pixel 7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/116.0.5845.163 Mobile Safari/537.36 [Pinterest/Android]`)
+	want.Type = Browser
+	want.OS = "Android"
+	want.OSVersion = mustParse("13.0.0")
+	want.Name = "Chrome"
+	want.Version = mustParse("116.0.5845")
+	want.Security = SecurityUnknown
+	want.Mobile = true
+	want.Tablet = false
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	}
+	got = Parse(`Mozilla/5.0 (Linux; Android 13; Pixel 7 Build/TQ3A.230805.001; wv) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/116.0.5845.163 Mobile Safari/537.36 [Pinterest/Android]`)
+	if got == nil || got.Type != Library || got.Name != "WebView" {
+		t.Errorf("expected WebView, got %v", got)
+	}
+	got = Parse(`Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Mobile/15E148 [Pinterest/iOS]`)
+	if got == nil || got.Type != Library || got.Name != "WebView" {
+		t.Errorf("expected WebView, got %v", got)
+	}
+}
+
+func TestSEOCrawler(t *testing.T) {
+	var got *UserAgent
+	want := &UserAgent{}
+
+	got = Parse(`Mozilla/5.0 (compatible; AhrefsBot/7.0; +http://ahrefs.com/robot/)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "AhrefsBot"
+	want.Version = mustParse("7.0.0")
+	want.Security = SecurityUnknown
+	want.Operator = "Ahrefs"
+	want.Purpose = PurposeSEO
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator || got.Purpose != want.Purpose {
+		t.Errorf("expected %q %s, got %q %s\n", want.Operator, want.Purpose, got.Operator, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; SemrushBot/7~bl; +http://www.semrush.com/bot.html)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "SemrushBot"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Operator = "Semrush"
+	want.Purpose = PurposeSEO
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator || got.Purpose != want.Purpose {
+		t.Errorf("expected %q %s, got %q %s\n", want.Operator, want.Purpose, got.Operator, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; SiteAuditBot/0.97; +http://www.semrush.com/bot.html)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "SiteAuditBot"
+	want.Version = mustParse("0.97.0")
+	want.Security = SecurityUnknown
+	want.Operator = "Semrush"
+	want.Purpose = PurposeSEO
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator || got.Purpose != want.Purpose {
+		t.Errorf("expected %q %s, got %q %s\n", want.Operator, want.Purpose, got.Operator, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; MJ12bot/v1.4.8; http://mj12bot.com/)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "MJ12bot"
+	want.Version = mustParse("1.4.8")
+	want.Security = SecurityUnknown
+	want.Operator = "Majestic"
+	want.Purpose = PurposeSEO
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator || got.Purpose != want.Purpose {
+		t.Errorf("expected %q %s, got %q %s\n", want.Operator, want.Purpose, got.Operator, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; DotBot/1.2; +https://opensiteexplorer.org/dotbot; help@moz.com)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "DotBot"
+	want.Version = mustParse("1.2.0")
+	want.Security = SecurityUnknown
+	want.Operator = "Moz"
+	want.Purpose = PurposeSEO
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator || got.Purpose != want.Purpose {
+		t.Errorf("expected %q %s, got %q %s\n", want.Operator, want.Purpose, got.Operator, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`rogerbot/1.2 (https://moz.com/help/moz-procedures/crawlers/rogerbot, rogerbot-crawler+aardwolf-production-crawler-42@moz.com)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "rogerbot"
+	want.Version = mustParse("1.2.0")
+	want.Security = SecurityUnknown
+	want.Operator = "Moz"
+	want.Purpose = PurposeSEO
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator || got.Purpose != want.Purpose {
+		t.Errorf("expected %q %s, got %q %s\n", want.Operator, want.Purpose, got.Operator, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; BLEXBot/1.0; +http://webmeup-crawler.com/)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "BLEXBot"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	want.Operator = "WebMeUp"
+	want.Purpose = PurposeSEO
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator || got.Purpose != want.Purpose {
+		t.Errorf("expected %q %s, got %q %s\n", want.Operator, want.Purpose, got.Operator, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; DataForSeoBot/1.0; +https://dataforseo.com/dataforseo-bot)`)
+	want.Type = Crawler
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "DataForSeoBot"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	want.Operator = "DataForSEO"
+	want.Purpose = PurposeSEO
+	if !eqUA(want, got) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator || got.Purpose != want.Purpose {
+		t.Errorf("expected %q %s, got %q %s\n", want.Operator, want.Purpose, got.Operator, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
 	}
 
-	got = Parse(`AdsBot-Google (+http://www.google.com/adsbot.html)`)
+	got = Parse(`Mozilla/5.0 (compatible; serpstatbot/2.1; +https://serpstatbot.com/)`)
 	want.Type = Crawler
 	want.OS = "unknown"
 	want.OSVersion = semver.Version{}
-	want.Name = "Google AdsBot"
-	want.Version = semver.Version{}
+	want.Name = "serpstatbot"
+	want.Version = mustParse("2.1.0")
 	want.Security = SecurityUnknown
+	want.Operator = "Serpstat"
+	want.Purpose = PurposeSEO
 	if !eqUA(want, got) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator || got.Purpose != want.Purpose {
+		t.Errorf("expected %q %s, got %q %s\n", want.Operator, want.Purpose, got.Operator, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
 	}
 
-	got = Parse(`AdsBot-Google-Mobile-Apps`)
+	got = Parse(`Screaming Frog SEO Spider/19.0`)
 	want.Type = Crawler
 	want.OS = "unknown"
 	want.OSVersion = semver.Version{}
-	want.Name = "Google AdsBot"
+	want.Name = "Screaming Frog SEO Spider"
+	want.Version = mustParse("19.0.0")
+	want.Security = SecurityUnknown
+	want.Operator = "Screaming Frog"
+	want.Purpose = PurposeSEO
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator || got.Purpose != want.Purpose {
+		t.Errorf("expected %q %s, got %q %s\n", want.Operator, want.Purpose, got.Operator, got.Purpose)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	if got := Parse(`Mozilla/5.0 (compatible; bingbot/2.0; +http://www.bing.com/bingbot.htm)`); got.Operator != "Microsoft" {
+		t.Errorf("expected Microsoft, got %q", got.Operator)
+	}
+	if got := Parse(`Mozilla/5.0 (compatible; YandexImages/3.0; +http://yandex.com/bots)`); got.Operator != "Yandex" {
+		t.Errorf("expected Yandex, got %q", got.Operator)
+	}
+}
+
+func TestMonitor(t *testing.T) {
+	var got *UserAgent
+	want := &UserAgent{}
+
+	got = Parse(`Mozilla/5.0+(compatible; UptimeRobot/2.0; http://www.uptimerobot.com/)`)
+	want.Type = Monitor
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "UptimeRobot"
+	want.Version = mustParse("2.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Pingdom.com_bot_version_1.4_(http://www.pingdom.com/)`)
+	want.Type = Monitor
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Pingdom"
 	want.Version = semver.Version{}
 	want.Security = SecurityUnknown
 	if !eqUA(want, got) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
 	}
 
-	got = Parse(`Mozilla/5.0 (Linux; Android 6.0.1; Nexus 5X Build/MMB29P) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/41.0.2272.96 Mobile Safari/537.36 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)`)
-	want.Type = Crawler
-	want.Mobile = true
+	got = Parse(`Mozilla/5.0 (Unknown; Linux x86_64) AppleWebKit/534.34 (KHTML, like Gecko) PingdomTMS/0.8.5 Safari/534.34`)
+	want.Type = Monitor
 	want.OS = "unknown"
 	want.OSVersion = semver.Version{}
-	want.Name = "Googlebot"
-	want.Version = mustParse("2.1")
+	want.Name = "Pingdom"
+	want.Version = mustParse("0.8.5")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/107.0.0.0 Safari/537.36 StatusCake`)
+	want.Type = Monitor
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "StatusCake"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (Windows NT 6.1; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/65.0.3325.181 Safari/537.36 Site24x7`)
+	want.Type = Monitor
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Site24x7"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Better Uptime Bot Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/93.0.4577.63 Safari/537.36`)
+	want.Type = Monitor
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Better Uptime"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Datadog/Synthetics`)
+	want.Type = Monitor
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Datadog Synthetics"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 DatadogSynthetics`)
+	want.Type = Monitor
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Datadog Synthetics"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 NewRelicSynthetics/1.0`)
+	want.Type = Monitor
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "New Relic Synthetics"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Checkly/1.0 (https://www.checklyhq.com)`)
+	want.Type = Monitor
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Checkly"
+	want.Version = mustParse("1.0.0")
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`GoogleStackdriverMonitoring-UptimeChecks(https://cloud.google.com/monitoring)`)
+	want.Type = Monitor
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Google Cloud Monitoring"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Amazon-Route53-Health-Check-Service (ref 1b2c3d4e-5f6a-7b8c-9d0e-1f2a3b4c5d6e; report http://amzn.to/1vsZADi)`)
+	want.Type = Monitor
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Route 53 Health Checks"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+}
+
+func TestScanner(t *testing.T) {
+	var got *UserAgent
+	want := &UserAgent{}
+
+	got = Parse(`sqlmap/1.7.2#stable (https://sqlmap.org)`)
+	want.Type = Scanner
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "sqlmap"
+	want.Version = mustParse("1.7.2")
+	want.Security = SecurityUnknown
+	want.Operator = "sqlmap project"
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator {
+		t.Errorf("expected %q, got %q\n", want.Operator, got.Operator)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.00 (Nikto/2.1.6) (Evasions:None) (Test:Port Check)`)
+	want.Type = Scanner
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Nikto"
+	want.Version = mustParse("2.1.6")
 	want.Security = SecurityUnknown
+	want.Operator = "CIRT.net"
 	if !eqUA(want, got) {
 		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator {
+		t.Errorf("expected %q, got %q\n", want.Operator, got.Operator)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; Nmap Scripting Engine; https://nmap.org/book/nse.html)`)
+	want.Type = Scanner
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Nmap"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Operator = "Nmap Project"
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator {
+		t.Errorf("expected %q, got %q\n", want.Operator, got.Operator)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`masscan/1.3 (https://github.com/robertdavidgraham/masscan)`)
+	want.Type = Scanner
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "masscan"
+	want.Version = mustParse("1.3.0")
+	want.Security = SecurityUnknown
+	want.Operator = "Robert David Graham"
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator {
+		t.Errorf("expected %q, got %q\n", want.Operator, got.Operator)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 zgrab/0.x`)
+	want.Type = Scanner
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "zgrab"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Operator = "ZMap Project"
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator {
+		t.Errorf("expected %q, got %q\n", want.Operator, got.Operator)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Nuclei - Open-source project (github.com/projectdiscovery/nuclei)`)
+	want.Type = Scanner
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Nuclei"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Operator = "ProjectDiscovery"
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator {
+		t.Errorf("expected %q, got %q\n", want.Operator, got.Operator)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`WPScan v3.8.22 (https://wpscan.com/wordpress-security-scanner)`)
+	want.Type = Scanner
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "WPScan"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Operator = "Automattic"
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator {
+		t.Errorf("expected %q, got %q\n", want.Operator, got.Operator)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/87.0.4280.88 Safari/537.36 Acunetix-Agent`)
+	want.Type = Scanner
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Acunetix"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Operator = "Invicti"
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator {
+		t.Errorf("expected %q, got %q\n", want.Operator, got.Operator)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; Burp Collaborator)`)
+	want.Type = Scanner
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Burp Collaborator"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Operator = "PortSwigger"
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator {
+		t.Errorf("expected %q, got %q\n", want.Operator, got.Operator)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 [en] (X11, U; OpenVAS-VT 21.4.4)`)
+	want.Type = Scanner
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "OpenVAS"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Operator = "Greenbone"
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator {
+		t.Errorf("expected %q, got %q\n", want.Operator, got.Operator)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`DirBuster-1.0-RC1 (http://www.owasp.org/index.php/Category:OWASP_DirBuster_Project)`)
+	want.Type = Scanner
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "DirBuster"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Operator = "OWASP"
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator {
+		t.Errorf("expected %q, got %q\n", want.Operator, got.Operator)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`gobuster/3.6`)
+	want.Type = Scanner
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "gobuster"
+	want.Version = mustParse("3.6.0")
+	want.Security = SecurityUnknown
+	want.Operator = "OJ Reeves"
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator {
+		t.Errorf("expected %q, got %q\n", want.Operator, got.Operator)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; CensysInspect/1.1; +https://about.censys.io/)`)
+	want.Type = Scanner
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Censys"
+	want.Version = mustParse("1.1.0")
+	want.Security = SecurityUnknown
+	want.Operator = "Censys"
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator {
+		t.Errorf("expected %q, got %q\n", want.Operator, got.Operator)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; Shodan; +https://www.shodan.io)`)
+	want.Type = Scanner
+	want.OS = "unknown"
+	want.OSVersion = semver.Version{}
+	want.Name = "Shodan"
+	want.Version = semver.Version{}
+	want.Security = SecurityUnknown
+	want.Operator = "Shodan"
+	if !eqUA(want, got) {
+		t.Errorf("expected %+v, got %+v\n", want, got)
+	} else if got.Operator != want.Operator {
+		t.Errorf("expected %q, got %q\n", want.Operator, got.Operator)
+	} else if got.URL == nil {
+		t.Errorf("expected a URL for %s\n", got.Name)
+	}
+}
+
+func TestCrawlerInfo(t *testing.T) {
+	for _, m := range []map[string]CrawlerInfo{crawlers, previewers, monitors, validators, scanners} {
+		for name, info := range m {
+			if info.URL == nil || info.Operator == "" {
+				t.Errorf("no URL or operator for %s", name)
+			}
+		}
+	}
+
+	var got *UserAgent
+	var want CrawlerInfo
+
+	got = Parse(`Googlebot-Image/1.0`)
+	want = CrawlerInfo{URL: got.URL, Operator: "Google", Purpose: PurposeSearch, RobotsToken: "Googlebot-Image", RespectsRobots: true}
+	if info, ok := got.Crawler(); !ok || info != want || got.URL == nil {
+		t.Errorf("expected %+v, got %+v\n", want, info)
+	} else if got.Operator != want.Operator || got.Purpose != want.Purpose {
+		t.Errorf("expected %q %s, got %q %s\n", want.Operator, want.Purpose, got.Operator, got.Purpose)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)`)
+	want = CrawlerInfo{URL: got.URL, Operator: "Google", Purpose: PurposeSearch, RobotsToken: "Googlebot", RendersJS: true, RespectsRobots: true}
+	if info, ok := got.Crawler(); !ok || info != want || got.URL == nil {
+		t.Errorf("expected %+v, got %+v\n", want, info)
+	} else if got.Operator != want.Operator || got.Purpose != want.Purpose {
+		t.Errorf("expected %q %s, got %q %s\n", want.Operator, want.Purpose, got.Operator, got.Purpose)
+	}
+
+	got = Parse(`AdsBot-Google (+http://www.google.com/adsbot.html)`)
+	want = CrawlerInfo{URL: got.URL, Operator: "Google", Purpose: PurposeAds, RobotsToken: "AdsBot-Google", RendersJS: true, RespectsRobots: true}
+	if info, ok := got.Crawler(); !ok || info != want || got.URL == nil {
+		t.Errorf("expected %+v, got %+v\n", want, info)
+	} else if got.Operator != want.Operator || got.Purpose != want.Purpose {
+		t.Errorf("expected %q %s, got %q %s\n", want.Operator, want.Purpose, got.Operator, got.Purpose)
+	}
+
+	got = Parse(`Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko; compatible; GPTBot/1.1; +https://openai.com/gptbot)`)
+	want = CrawlerInfo{URL: got.URL, Operator: "OpenAI", Purpose: PurposeTraining, RobotsToken: "GPTBot", RespectsRobots: true}
+	if info, ok := got.Crawler(); !ok || info != want || got.URL == nil {
+		t.Errorf("expected %+v, got %+v\n", want, info)
+	} else if got.Operator != want.Operator || got.Purpose != want.Purpose {
+		t.Errorf("expected %q %s, got %q %s\n", want.Operator, want.Purpose, got.Operator, got.Purpose)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; AhrefsBot/7.0; +http://ahrefs.com/robot/)`)
+	want = CrawlerInfo{URL: got.URL, Operator: "Ahrefs", Purpose: PurposeSEO, RobotsToken: "AhrefsBot", RespectsRobots: true}
+	if info, ok := got.Crawler(); !ok || info != want || got.URL == nil {
+		t.Errorf("expected %+v, got %+v\n", want, info)
+	} else if got.Operator != want.Operator || got.Purpose != want.Purpose {
+		t.Errorf("expected %q %s, got %q %s\n", want.Operator, want.Purpose, got.Operator, got.Purpose)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; archive.org_bot +http://archive.org/details/archive.org_bot) Zeno/b6b8b9a warc/v0.8.50`)
+	want = CrawlerInfo{URL: got.URL, Operator: "Internet Archive", Purpose: PurposeArchive, RobotsToken: "archive.org_bot"}
+	if info, ok := got.Crawler(); !ok || info != want || got.URL == nil {
+		t.Errorf("expected %+v, got %+v\n", want, info)
+	} else if got.Operator != want.Operator || got.Purpose != want.Purpose {
+		t.Errorf("expected %q %s, got %q %s\n", want.Operator, want.Purpose, got.Operator, got.Purpose)
+	}
+
+	got = Parse(`facebookexternalhit/1.1 (+http://www.facebook.com/externalhit_uatext.php)`)
+	want = CrawlerInfo{URL: got.URL, Operator: "Meta", Purpose: PurposePreview, RobotsToken: "facebookexternalhit"}
+	if info, ok := got.Crawler(); !ok || info != want || got.URL == nil {
+		t.Errorf("expected %+v, got %+v\n", want, info)
+	} else if got.Operator != want.Operator || got.Purpose != want.Purpose {
+		t.Errorf("expected %q %s, got %q %s\n", want.Operator, want.Purpose, got.Operator, got.Purpose)
+	}
+
+	got = Parse(`Mozilla/5.0+(compatible; UptimeRobot/2.0; http://www.uptimerobot.com/)`)
+	want = CrawlerInfo{URL: got.URL, Operator: "UptimeRobot", Purpose: PurposeMonitor}
+	if info, ok := got.Crawler(); !ok || info != want || got.URL == nil {
+		t.Errorf("expected %+v, got %+v\n", want, info)
+	} else if got.Operator != want.Operator || got.Purpose != want.Purpose {
+		t.Errorf("expected %q %s, got %q %s\n", want.Operator, want.Purpose, got.Operator, got.Purpose)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; YandexMetrika/2.0; +http://yandex.com/bots)`)
+	want = CrawlerInfo{URL: got.URL, Operator: "Yandex"}
+	if info, ok := got.Crawler(); !ok || info != want || got.URL == nil {
+		t.Errorf("expected %+v, got %+v\n", want, info)
+	} else if got.Operator != want.Operator || got.Purpose != want.Purpose {
+		t.Errorf("expected %q %s, got %q %s\n", want.Operator, want.Purpose, got.Operator, got.Purpose)
+	}
+
+	got = Parse(`Mozilla/5.0 (compatible; YandexDirect/3.0; +http://yandex.com/bots)`)
+	want = CrawlerInfo{URL: got.URL, Operator: "Yandex", Purpose: PurposeAds, RobotsToken: "YandexDirect", RespectsRobots: true}
+	if info, ok := got.Crawler(); !ok || info != want || got.URL == nil {
+		t.Errorf("expected %+v, got %+v\n", want, info)
+	} else if got.Operator != want.Operator || got.Purpose != want.Purpose {
+		t.Errorf("expected %q %s, got %q %s\n", want.Operator, want.Purpose, got.Operator, got.Purpose)
+	}
+
+	got = Parse(`sqlmap/1.7.2#stable (https://sqlmap.org)`)
+	want = CrawlerInfo{URL: got.URL, Operator: "sqlmap project"}
+	if info, ok := got.Crawler(); !ok || info != want || got.URL == nil {
+		t.Errorf("expected %+v, got %+v\n", want, info)
+	} else if got.Operator != want.Operator || got.Purpose != want.Purpose {
+		t.Errorf("expected %q %s, got %q %s\n", want.Operator, want.Purpose, got.Operator, got.Purpose)
+	}
+
+	// all the robots parseYandex knows
+	for _, name := range []string{"YandexBot", "YandexMobileBot", "YandexAccessibilityBot", "YandexScreenshotBot", "YandexImages", "YandexVideo", "YandexMedia", "YandexMetrika", "YandexDirect", "YandexDirectDyn", "YandexFavicons", "YandexBlogs", "YandexNews", "YandexMarket", "YandexWebmaster", "YandexScreenshot", "YandexVerticals", "YandexTracker", "YandexCalendar", "YandexAdditional", "YandexCatalog", "YandexSitelinks", "YandexSpravBot", "YandexSpravbot"} {
+		got = Parse(`Mozilla/5.0 (compatible; ` + name + `/1.0; +http://yandex.com/bots)`)
+		if _, ok := got.Crawler(); !ok || got.Name != name {
+			t.Errorf("no crawler info for %s", name)
+		}
+	}
+	// unknown robots are still Yandex's
+	got = Parse(`Mozilla/5.0 (compatible; YandexSomethingNewBot/1.0; +http://yandex.com/bots)`)
+	if _, ok := got.Crawler(); ok || got.Operator != "Yandex" || got.URL == nil {
+		t.Errorf("expected an unknown Yandex robot, got %+v", got)
+	}
+
+	if _, ok := Parse(`curl/8.4.0`).Crawler(); ok {
+		t.Errorf("expected no crawler info for curl")
 	}
 }
@@ -0,0 +1,50 @@
+// Written by https://xojoc.pw. GPLv3 or later.
+
+package useragent
+
+// Keep them sorted
+var previewers = map[string]CrawlerInfo{
+	"Discordbot":          {URL: u("https://discord.com/"), Operator: "Discord", Purpose: PurposePreview, RobotsToken: "Discordbot"},
+	"Embedly":             {URL: u("https://embed.ly/"), Operator: "Embedly", Purpose: PurposePreview, RobotsToken: "Embedly"},
+	"Facebot":             {URL: u("https://developers.facebook.com/docs/sharing/webmasters/web-crawlers"), Operator: "Meta", Purpose: PurposePreview, RobotsToken: "Facebot", RespectsRobots: true},
+	"Iframely":            {URL: u("https://iframely.com/docs/about"), Operator: "Iframely", Purpose: PurposePreview, RobotsToken: "Iframely"},
+	"LinkedInBot":         {URL: u("https://www.linkedin.com/"), Operator: "LinkedIn", Purpose: PurposePreview, RobotsToken: "LinkedInBot", RespectsRobots: true},
+	"Mastodon":            {URL: u("https://joinmastodon.org/"), Operator: "Mastodon", Purpose: PurposePreview},
+	"Pinterestbot":        {URL: u("http://www.pinterest.com/bot.html"), Operator: "Pinterest", Purpose: PurposePreview, RobotsToken: "Pinterestbot", RespectsRobots: true},
+	"Skype":               {URL: u("https://www.skype.com/"), Operator: "Microsoft", Purpose: PurposePreview},
+	"Slackbot":            {URL: u("https://api.slack.com/robots"), Operator: "Slack", Purpose: PurposePreview, RobotsToken: "Slackbot", RespectsRobots: true},
+	"TelegramBot":         {URL: u("https://telegram.org/"), Operator: "Telegram", Purpose: PurposePreview, RobotsToken: "TelegramBot"},
+	"Twitterbot":          {URL: u("https://developer.x.com/en/docs/x-for-websites/cards/guides/getting-started"), Operator: "X", Purpose: PurposePreview, RobotsToken: "Twitterbot", RespectsRobots: true},
+	"WhatsApp":            {URL: u("https://www.whatsapp.com/"), Operator: "Meta", Purpose: PurposePreview},
+	"facebookexternalhit": {URL: u("https://developers.facebook.com/docs/sharing/webmasters/web-crawlers"), Operator: "Meta", Purpose: PurposePreview, RobotsToken: "facebookexternalhit"},
+	"redditbot":           {URL: u("https://www.reddit.com/"), Operator: "Reddit", Purpose: PurposePreview, RobotsToken: "redditbot"},
+}
+
+// Bots fetching pages to render link previews, more specific tokens first
+// (e.g. "TelegramBot (like TwitterBot)", "http.rb/5.1.1 (Mastodon/4.2.0; +https://mastodon.social/)").
+var previewerProducts = []product{
+	{"facebookexternalhit", "facebookexternalhit"},
+	{"Facebot", "Facebot"},
+	{"TelegramBot", "TelegramBot"},
+	{"Twitterbot", "Twitterbot"},
+	{"LinkedInBot", "LinkedInBot"},
+	{"Slackbot-LinkExpanding", "Slackbot"},
+	{"Discordbot", "Discordbot"},
+	{"WhatsApp/", "WhatsApp"},
+	{"Pinterestbot", "Pinterestbot"},
+	{"Pinterest/", "Pinterestbot"},
+	{"redditbot", "redditbot"},
+	{"Embedly", "Embedly"},
+	{"Iframely", "Iframely"},
+	{"Mastodon/", "Mastodon"},
+	{"SkypeUriPreview", "Skype"},
+}
+
+func parsePreviewer(l *lex) *UserAgent {
+	ua := parseProduct(l, Previewer, previewerProducts, nil)
+	if ua == nil {
+		return nil
+	}
+	setCrawlerInfo(ua)
+	return ua
+}
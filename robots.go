@@ -0,0 +1,239 @@
+// Written by https://xojoc.pw. GPLv3 or later.
+
+package useragent
+
+import (
+	"bytes"
+	"strings"
+)
+
+// robots.txt as specified by RFC 9309: https://www.rfc-editor.org/rfc/rfc9309
+
+type robotsRule struct {
+	allow   bool
+	pattern string
+}
+
+type robotsGroup struct {
+	// lower case product tokens
+	agents []string
+	rules  []robotsRule
+}
+
+func parseRobots(robots []byte) []*robotsGroup {
+	var groups []*robotsGroup
+	var cur *robotsGroup
+	// consecutive user-agent lines share the same group
+	inAgents := false
+	robots = bytes.TrimPrefix(robots, []byte("\xef\xbb\xbf"))
+	// lines may end with \r\n, \r or \n
+	lines := strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(string(robots))
+	for _, line := range strings.Split(lines, "\n") {
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		i := strings.IndexByte(line, ':')
+		if i < 0 {
+			continue
+		}
+		key := strings.ToLower(strings.TrimSpace(line[:i]))
+		value := strings.TrimSpace(line[i+1:])
+		switch key {
+		case "user-agent":
+			if cur == nil || !inAgents {
+				cur = &robotsGroup{}
+				groups = append(groups, cur)
+			}
+			cur.agents = append(cur.agents, robotsToken(value))
+			inAgents = true
+		case "allow", "disallow":
+			inAgents = false
+			// rules before any user-agent line and empty rules are ignored
+			if cur == nil || value == "" {
+				continue
+			}
+			cur.rules = append(cur.rules, robotsRule{key == "allow", robotsNormalize(value)})
+		}
+	}
+	return groups
+}
+
+// The product token is matched case insensitively and without a version
+// (e.g. "Googlebot/2.1" is "googlebot").
+func robotsToken(s string) string {
+	if i := strings.IndexAny(s, "/ \t"); i >= 0 {
+		s = s[:i]
+	}
+	return strings.ToLower(s)
+}
+
+// Tokens crawlers fall back to if there's no group for their own token.
+// Keep them sorted.
+var robotsFallbacks = map[string]string{
+	"Google-InspectionTool": "Googlebot",
+	"GoogleOther-Image":     "GoogleOther",
+	"GoogleOther-Video":     "GoogleOther",
+	"Googlebot-Image":       "Googlebot",
+	"Googlebot-News":        "Googlebot",
+	"Googlebot-Video":       "Googlebot",
+}
+
+// Crawlers that only obey groups naming them, ignoring the * group.
+// Keep them sorted.
+var robotsIgnoresStar = map[string]bool{
+	"AdsBot-Google":        true,
+	"AdsBot-Google-Mobile": true,
+	"Mediapartners-Google": true,
+}
+
+// The product tokens ua looks for in robots.txt, most specific first.
+func robotsTokens(ua *UserAgent) []string {
+	if ua == nil {
+		return nil
+	}
+	var token string
+	if info, ok := ua.Crawler(); ok {
+		token = info.RobotsToken
+	} else if ua.Type == Crawler {
+		token = ua.Name
+	}
+	var tokens []string
+	if token != "" {
+		tokens = append(tokens, token)
+		if f, ok := robotsFallbacks[token]; ok {
+			tokens = append(tokens, f)
+		}
+	}
+	// "Yandex" applies to all Yandex robots
+	if ua.Operator == "Yandex" {
+		tokens = append(tokens, "Yandex")
+	}
+	return tokens
+}
+
+// Percent-encode octets outside US-ASCII (and spaces and control characters) and
+// decode percent-encoded unreserved characters, so that paths and patterns can be
+// compared as per RFC 9309 section 2.2.2 (e.g. "/%62%61%7A" is "/baz" and
+// "/ツ" is "/%E3%83%84").
+func robotsNormalize(s string) string {
+	const hex = "0123456789ABCDEF"
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '%' && i+2 < len(s) && isHex(s[i+1]) && isHex(s[i+2]) {
+			d := unhex(s[i+1])<<4 | unhex(s[i+2])
+			if isAlnum(d) || strings.IndexByte("-._~", d) >= 0 {
+				b.WriteByte(d)
+			} else {
+				b.WriteByte('%')
+				b.WriteByte(hex[d>>4])
+				b.WriteByte(hex[d&15])
+			}
+			i += 2
+		} else if c <= ' ' || c >= 0x7f {
+			b.WriteByte('%')
+			b.WriteByte(hex[c>>4])
+			b.WriteByte(hex[c&15])
+		} else {
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+func isHex(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+func unhex(c byte) byte {
+	switch {
+	case c >= 'a':
+		return c - 'a' + 10
+	case c >= 'A':
+		return c - 'A' + 10
+	default:
+		return c - '0'
+	}
+}
+
+// Match a path against an Allow/Disallow pattern: * matches any sequence
+// of characters and a final $ matches the end of the path.
+func robotsMatch(pattern, path string) bool {
+	anchored := strings.HasSuffix(pattern, "$")
+	pattern = strings.TrimSuffix(pattern, "$")
+	parts := strings.Split(pattern, "*")
+	if !strings.HasPrefix(path, parts[0]) {
+		return false
+	}
+	path = path[len(parts[0]):]
+	if len(parts) == 1 {
+		return !anchored || path == ""
+	}
+	for i, p := range parts[1:] {
+		if anchored && i == len(parts)-2 {
+			return strings.HasSuffix(path, p)
+		}
+		j := strings.Index(path, p)
+		if j < 0 {
+			return false
+		}
+		path = path[j+len(p):]
+	}
+	return true
+}
+
+// Allowed reports whether the robots.txt file robots allows ua to fetch path
+// (including the query, e.g. "/search?q=go"), following RFC 9309:
+// the groups for the crawler's robots.txt product token (see CrawlerInfo) are used
+// or, if there are none, the groups for *. The longest matching rule wins and
+// Allow wins ties. Everything is allowed if no rule matches.
+//
+// Crawlers documented as falling back to another token (e.g. Googlebot-Image to Googlebot)
+// do so, and those documented as ignoring the * groups (e.g. AdsBot-Google) ignore them.
+// Paths and patterns are compared after normalizing their percent-encoding.
+// ua may be nil, in which case only the * groups are used.
+func Allowed(robots []byte, ua *UserAgent, path string) bool {
+	if path == "" {
+		path = "/"
+	}
+	if path == "/robots.txt" {
+		return true
+	}
+	path = robotsNormalize(path)
+
+	groups := parseRobots(robots)
+	var rules []robotsRule
+	found := false
+	tokens := robotsTokens(ua)
+	if len(tokens) == 0 || !robotsIgnoresStar[tokens[0]] {
+		tokens = append(tokens, "*")
+	}
+	for _, token := range tokens {
+		token = robotsToken(token)
+		for _, g := range groups {
+			for _, a := range g.agents {
+				if a == token {
+					rules = append(rules, g.rules...)
+					found = true
+					break
+				}
+			}
+		}
+		if found {
+			break
+		}
+	}
+
+	allowed := true
+	longest := -1
+	for _, r := range rules {
+		if !robotsMatch(r.pattern, path) {
+			continue
+		}
+		if len(r.pattern) > longest || len(r.pattern) == longest && r.allow {
+			allowed = r.allow
+			longest = len(r.pattern)
+		}
+	}
+	return allowed
+}
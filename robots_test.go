@@ -0,0 +1,158 @@
+// Written by https://xojoc.pw. GPLv3 or later.
+
+package useragent
+
+import (
+	"testing"
+)
+
+func TestRobotsMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"/", "/anything", true},
+		{"/fish", "/fish.html", true},
+		{"/fish", "/Fish.asp", false},
+		{"/fish*", "/fishheads/yummy.html", true},
+		{"/fish/", "/fish", false},
+		{"/*.php", "/folder/filename.php?parameters", true},
+		{"/*.php", "/windows.PHP", false},
+		{"/*.php$", "/filename.php", true},
+		{"/*.php$", "/filename.php?parameters", false},
+		{"/fish*.php", "/fishheads/catfish.php?parameters", true},
+		{"/fish*.php", "/Fish.PHP", false},
+		{"/a/*/c$", "/a/b/c", true},
+		{"/a/*/c$", "/a/b/c/d", false},
+		{"/$", "/", true},
+		{"/$", "/a", false},
+	}
+	for _, test := range tests {
+		if got := robotsMatch(test.pattern, test.path); got != test.want {
+			t.Errorf("%s %s: expected %v, got %v", test.pattern, test.path, test.want, got)
+		}
+	}
+}
+
+func TestRobotsNormalize(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"/foo/bar?baz=quz", "/foo/bar?baz=quz"},
+		{"/foo/bar/ツ", "/foo/bar/%E3%83%84"},
+		{"/foo/bar/%E3%83%84", "/foo/bar/%E3%83%84"},
+		{"/foo/bar/%e3%83%84", "/foo/bar/%E3%83%84"},
+		{"/foo/bar/%62%61%7A", "/foo/bar/baz"},
+		{"/a%2Fb", "/a%2Fb"},
+		{"/a b", "/a%20b"},
+		{"/100%", "/100%"},
+		{"/*.php$", "/*.php$"},
+	}
+	for _, test := range tests {
+		if got := robotsNormalize(test.s); got != test.want {
+			t.Errorf("%s: expected %s, got %s", test.s, test.want, got)
+		}
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	robots := []byte(`# example robots.txt
+User-agent: *
+Disallow: /private/
+Allow: /private/public.html
+
+User-Agent: Googlebot
+user-agent: Bingbot/2.0
+Disallow: /nogoogle
+Allow: /nogoogle/but-this$
+
+User-agent: GPTBot
+Disallow: /
+
+User-agent: Googlebot-News
+Disallow: /archive/
+
+User-agent: Yandex
+Disallow: /ru/
+
+Sitemap: https://example.com/sitemap.xml
+`)
+
+	googlebot := Parse(`Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)`)
+	googlebotImages := Parse(`Googlebot-Image/1.0`)
+	googlebotNews := Parse(`Googlebot-News`)
+	bingbot := Parse(`Mozilla/5.0 (compatible; bingbot/2.0; +http://www.bing.com/bingbot.htm)`)
+	gptbot := Parse(`Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko; compatible; GPTBot/1.1; +https://openai.com/gptbot)`)
+	yandexImages := Parse(`Mozilla/5.0 (compatible; YandexImages/3.0; +http://yandex.com/bots)`)
+	ahrefs := Parse(`Mozilla/5.0 (compatible; AhrefsBot/7.0; +http://ahrefs.com/robot/)`)
+
+	tests := []struct {
+		ua   *UserAgent
+		path string
+		want bool
+	}{
+		{googlebot, "/private/secret.html", true},
+		{googlebot, "/nogoogle/page", false},
+		{googlebot, "/nogoogle/but-this", true},
+		{googlebot, "/nogoogle/but-this?x=1", false},
+		// no Googlebot-Image group: falls back to Googlebot
+		{googlebotImages, "/nogoogle/image.png", false},
+		{googlebotImages, "/private/secret.html", true},
+		// a Googlebot-News group exists, Googlebot's rules don't apply
+		{googlebotNews, "/nogoogle/page", true},
+		{googlebotNews, "/archive/2020", false},
+		{bingbot, "/nogoogle", false},
+		{gptbot, "/", false},
+		{gptbot, "/robots.txt", true},
+		{yandexImages, "/ru/", false},
+		{yandexImages, "/private/secret.html", true},
+		{ahrefs, "/private/secret.html", false},
+		{ahrefs, "/private/public.html", true},
+		{ahrefs, "", true},
+		{nil, "/private/secret.html", false},
+	}
+	for _, test := range tests {
+		name := "nil"
+		if test.ua != nil {
+			name = test.ua.Name
+		}
+		if got := Allowed(robots, test.ua, test.path); got != test.want {
+			t.Errorf("%s %s: expected %v, got %v", name, test.path, test.want, got)
+		}
+	}
+
+	if !Allowed(nil, googlebot, "/anything") {
+		t.Errorf("expected everything to be allowed with an empty robots.txt")
+	}
+	if !Allowed([]byte("User-agent: *\nDisallow:\n"), googlebot, "/anything") {
+		t.Errorf("expected an empty Disallow to allow everything")
+	}
+	if Allowed([]byte("User-agent: *\nAllow: /page\nDisallow: /*.html\n"), googlebot, "/page.html") {
+		t.Errorf("expected the longest match to win")
+	}
+	if !Allowed([]byte("User-agent: *\nAllow: /page\nDisallow: /page\n"), googlebot, "/page") {
+		t.Errorf("expected Allow to win ties")
+	}
+	if Allowed([]byte("User-agent: *\nDisallow: /foo/bar/ツ\n"), googlebot, "/foo/bar/%e3%83%84") {
+		t.Errorf("expected percent-encoded paths to match")
+	}
+	if Allowed([]byte("User-agent: *\nDisallow: /%62%61%7A\n"), googlebot, "/baz") {
+		t.Errorf("expected percent-encoded unreserved characters to match")
+	}
+	if Allowed([]byte("User-agent: *\rDisallow: /\r"), googlebot, "/x") {
+		t.Errorf("expected CR line endings to work")
+	}
+	if Allowed([]byte("User-agent: *\r\nDisallow: /\r\n"), googlebot, "/x") {
+		t.Errorf("expected CRLF line endings to work")
+	}
+
+	adsbot := Parse(`AdsBot-Google (+http://www.google.com/adsbot.html)`)
+	if !Allowed([]byte("User-agent: *\nDisallow: /\n"), adsbot, "/landing") {
+		t.Errorf("expected AdsBot-Google to ignore the * group")
+	}
+	if Allowed([]byte("User-agent: *\nDisallow: /\n\nUser-agent: AdsBot-Google\nDisallow: /landing\n"), adsbot, "/landing") {
+		t.Errorf("expected AdsBot-Google to obey its own group")
+	}
+}
@@ -0,0 +1,50 @@
+// Written by https://xojoc.pw. GPLv3 or later.
+
+package useragent
+
+// Keep them sorted
+var scanners = map[string]CrawlerInfo{
+	"Acunetix":          {URL: u("https://www.acunetix.com/"), Operator: "Invicti"},
+	"Burp Collaborator": {URL: u("https://portswigger.net/burp/documentation/collaborator"), Operator: "PortSwigger"},
+	"Censys":            {URL: u("https://about.censys.io/"), Operator: "Censys"},
+	"DirBuster":         {URL: u("http://www.owasp.org/index.php/Category:OWASP_DirBuster_Project"), Operator: "OWASP"},
+	"Nikto":             {URL: u("https://cirt.net/Nikto2"), Operator: "CIRT.net"},
+	"Nmap":              {URL: u("https://nmap.org/book/nse.html"), Operator: "Nmap Project"},
+	"Nuclei":            {URL: u("https://github.com/projectdiscovery/nuclei"), Operator: "ProjectDiscovery"},
+	"OpenVAS":           {URL: u("https://www.openvas.org/"), Operator: "Greenbone"},
+	"Shodan":            {URL: u("https://www.shodan.io/"), Operator: "Shodan"},
+	"WPScan":            {URL: u("https://wpscan.com/"), Operator: "Automattic"},
+	"gobuster":          {URL: u("https://github.com/OJ/gobuster"), Operator: "OJ Reeves"},
+	"masscan":           {URL: u("https://github.com/robertdavidgraham/masscan"), Operator: "Robert David Graham"},
+	"sqlmap":            {URL: u("https://sqlmap.org/"), Operator: "sqlmap project"},
+	"zgrab":             {URL: u("https://github.com/zmap/zgrab2"), Operator: "ZMap Project"},
+}
+
+// Security scanners and attack tools, more specific tokens first
+var scannerProducts = []product{
+	{"sqlmap", "sqlmap"},
+	{"Nikto", "Nikto"},
+	{"Nmap Scripting Engine", "Nmap"},
+	{"masscan", "masscan"},
+	{"zgrab", "zgrab"},
+	{"github.com/projectdiscovery/nuclei", "Nuclei"},
+	{"Nuclei", "Nuclei"},
+	{"WPScan", "WPScan"},
+	{"Acunetix", "Acunetix"},
+	{"burpcollaborator", "Burp Collaborator"},
+	{"Burp Collaborator", "Burp Collaborator"},
+	{"OpenVAS", "OpenVAS"},
+	{"DirBuster", "DirBuster"},
+	{"gobuster", "gobuster"},
+	{"CensysInspect", "Censys"},
+	{"Shodan", "Shodan"},
+}
+
+func parseScanner(l *lex) *UserAgent {
+	ua := parseProduct(l, Scanner, scannerProducts, nil)
+	if ua == nil {
+		return nil
+	}
+	setCrawlerInfo(ua)
+	return ua
+}
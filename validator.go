@@ -0,0 +1,39 @@
+// Written by https://xojoc.pw. GPLv3 or later.
+
+package useragent
+
+// Keep them sorted
+var validators = map[string]CrawlerInfo{
+	"AMP Validator":                       {URL: u("https://validator.ampproject.org/"), Operator: "AMP Project"},
+	"Feed Validator":                      {URL: u("https://validator.w3.org/feed/"), Operator: "W3C"},
+	"Google Inspection Tool":              {URL: u("https://developers.google.com/search/docs/crawling-indexing/google-common-crawlers"), Operator: "Google", Purpose: PurposeUserFetch, RobotsToken: "Google-InspectionTool", RendersJS: true, RespectsRobots: true},
+	"Google Structured Data Testing Tool": {URL: u("https://developers.google.com/search/docs/appearance/structured-data"), Operator: "Google"},
+	"Nu Html Checker":                     {URL: u("https://validator.w3.org/nu/"), Operator: "W3C"},
+	"W3C CSS Validator":                   {URL: u("https://jigsaw.w3.org/css-validator/"), Operator: "W3C"},
+	"W3C Markup Validator":                {URL: u("https://validator.w3.org/"), Operator: "W3C"},
+	"W3C mobileOK Checker":                {URL: u("https://validator.w3.org/mobile/"), Operator: "W3C"},
+}
+
+// More specific tokens first.
+// Google-InspectionTool is used by the Rich Results Test and Search Console's URL Inspection.
+var validatorProducts = []product{
+	{"W3C_CSS_Validator_JFouffa", "W3C CSS Validator"},
+	{"W3C_CSS_Validator", "W3C CSS Validator"},
+	{"W3C_Validator", "W3C Markup Validator"},
+	{"W3C-mobileOK", "W3C mobileOK Checker"},
+	{"Validator.nu", "Nu Html Checker"},
+	{"FeedValidator", "Feed Validator"},
+	{"amphtml-validator", "AMP Validator"},
+	{"Google-InspectionTool", "Google Inspection Tool"},
+	{"Google-Structured-Data-Testing-Tool", "Google Structured Data Testing Tool"},
+}
+
+func parseValidator(l *lex) *UserAgent {
+	ua := parseProduct(l, Validator, validatorProducts, nil)
+	if ua == nil {
+		return nil
+	}
+	parseCrawlerDevice(l, ua)
+	setCrawlerInfo(ua)
+	return ua
+}
@@ -0,0 +1,127 @@
+// Written by https://xojoc.pw. GPLv3 or later.
+
+package useragent
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+)
+
+// Resolver does the DNS lookups needed by Verify. *net.Resolver implements it,
+// wrap it to add caching.
+type Resolver interface {
+	LookupAddr(ctx context.Context, addr string) ([]string, error)
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+var _ Resolver = net.DefaultResolver
+
+// Returned by Verify for crawlers whose operator doesn't document a DNS check.
+var ErrNotVerifiable = errors.New("useragent: crawler can't be verified with DNS")
+
+// Google's crawlers come from googlebot.com (or google.com for special cases).
+// Fetchers triggered by users (Feedfetcher, Site Verifier, ...) also come from
+// gae.googleusercontent.com, which anyone can rent, so they aren't listed here:
+// check them with IPRanges and user-triggered-fetchers.json. See
+// https://developers.google.com/search/docs/crawling-indexing/verifying-googlebot
+var (
+	googleCrawlerDomains = []string{"googlebot.com", "google.com"}
+	yandexDomains        = []string{"yandex.ru", "yandex.net", "yandex.com"}
+)
+
+// Domains the crawlers' reverse DNS names belong to, by name. Keep them sorted.
+var crawlerDomains = map[string][]string{
+	"APIs-Google":            googleCrawlerDomains,
+	"Amazonbot":              {"crawl.amazonbot.amazon"},
+	"Applebot":               {"applebot.apple.com"},
+	"Baiduspider":            {"crawl.baidu.com", "crawl.baidu.jp"},
+	"BingPreview":            {"search.msn.com"},
+	"Bingbot":                {"search.msn.com"},
+	"Google AdSense":         googleCrawlerDomains,
+	"Google AdsBot":          googleCrawlerDomains,
+	"Google AdsBot Mobile":   googleCrawlerDomains,
+	"Google Duplex":          googleCrawlerDomains,
+	"Google Inspection Tool": googleCrawlerDomains,
+	"Google Safety":          googleCrawlerDomains,
+	"Google Storebot":        googleCrawlerDomains,
+	"Google-CloudVertexBot":  googleCrawlerDomains,
+	"GoogleOther":            googleCrawlerDomains,
+	"GoogleOther Images":     googleCrawlerDomains,
+	"GoogleOther Video":      googleCrawlerDomains,
+	"Googlebot":              googleCrawlerDomains,
+	"Googlebot Images":       googleCrawlerDomains,
+	"Googlebot News":         googleCrawlerDomains,
+	"Googlebot Video":        googleCrawlerDomains,
+	"MSNBot":                 {"search.msn.com"},
+	"PetalBot":               {"petalsearch.com"},
+	"SeznamBot":              {"seznam.cz"},
+	"Sogou web spider":       {"crawl.sogou.com"},
+	"Yahoo! Slurp":           {"crawl.yahoo.net"},
+	"Yeti":                   {"naver.com"},
+	"coccocbot":              {"coccoc.com"},
+}
+
+// Verify checks that ip belongs to the crawler ua claims to be, doing the reverse
+// then forward DNS lookup documented by search engines:
+// the reverse DNS name of ip must be in one of the crawler's domains (e.g. googlebot.com)
+// and must resolve back to ip.
+//
+// Returns false and no error if the check fails, ErrNotVerifiable if ua isn't
+// a known crawler with a documented DNS check and the Resolver's error if a lookup fails.
+// Google's user triggered fetchers return ErrNotVerifiable, use IPRanges for them.
+func Verify(ctx context.Context, ua *UserAgent, ip net.IP, r Resolver) (bool, error) {
+	if ua == nil {
+		return false, ErrNotVerifiable
+	}
+	domains, ok := crawlerDomains[ua.Name]
+	// all Yandex robots come from Yandex's domains
+	if !ok && ua.Type == Crawler && ua.Operator == "Yandex" {
+		domains, ok = yandexDomains, true
+	}
+	if !ok {
+		return false, ErrNotVerifiable
+	}
+
+	names, err := r.LookupAddr(ctx, ip.String())
+	if err != nil {
+		if isNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSuffix(name, "."))
+		if !inDomains(name, domains) {
+			continue
+		}
+		addrs, err := r.LookupIPAddr(ctx, name)
+		if err != nil {
+			if isNotFound(err) {
+				continue
+			}
+			return false, err
+		}
+		for _, a := range addrs {
+			if a.IP.Equal(ip) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func inDomains(name string, domains []string) bool {
+	for _, d := range domains {
+		if name == d || strings.HasSuffix(name, "."+d) {
+			return true
+		}
+	}
+	return false
+}
+
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
@@ -0,0 +1,101 @@
+// Written by https://xojoc.pw. GPLv3 or later.
+
+package useragent
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+)
+
+// In-memory Resolver
+type stubResolver struct {
+	ptr map[string][]string
+	ips map[string][]string
+}
+
+func (r stubResolver) LookupAddr(ctx context.Context, addr string) ([]string, error) {
+	if names, ok := r.ptr[addr]; ok {
+		return names, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: addr, IsNotFound: true}
+}
+
+func (r stubResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	var addrs []net.IPAddr
+	for _, s := range r.ips[host] {
+		addrs = append(addrs, net.IPAddr{IP: net.ParseIP(s)})
+	}
+	if addrs == nil {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return addrs, nil
+}
+
+func TestVerify(t *testing.T) {
+	r := stubResolver{
+		ptr: map[string][]string{
+			"66.249.66.1":   {"crawl-66-249-66-1.googlebot.com."},
+			"157.55.39.1":   {"msnbot-157-55-39-1.search.msn.com."},
+			"203.0.113.7":   {"crawl-66-249-66-1.googlebot.com.evil.example."},
+			"203.0.113.8":   {"crawl-203-0-113-8.googlebot.com."},
+			"5.255.253.1":   {"5-255-253-1.spider.yandex.com."},
+			"2001:db8::bad": {"host.example.org."},
+			"34.1.2.3":      {"3.2.1.34.bc.googleusercontent.com."},
+			"34.1.2.4":      {"4-2-1-34.gae.googleusercontent.com."},
+		},
+		ips: map[string][]string{
+			"crawl-66-249-66-1.googlebot.com":              {"66.249.66.1"},
+			"msnbot-157-55-39-1.search.msn.com":            {"157.55.39.1"},
+			"crawl-66-249-66-1.googlebot.com.evil.example": {"203.0.113.7"},
+			// forward lookup doesn't match
+			"crawl-203-0-113-8.googlebot.com":    {"66.249.66.2"},
+			"5-255-253-1.spider.yandex.com":      {"5.255.253.1"},
+			"3.2.1.34.bc.googleusercontent.com":  {"34.1.2.3"},
+			"4-2-1-34.gae.googleusercontent.com": {"34.1.2.4"},
+		},
+	}
+	googlebot := Parse(`Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)`)
+	bingbot := Parse(`Mozilla/5.0 (compatible; bingbot/2.0; +http://www.bing.com/bingbot.htm)`)
+	yandex := Parse(`Mozilla/5.0 (compatible; YandexImages/3.0; +http://yandex.com/bots)`)
+
+	tests := []struct {
+		ua   *UserAgent
+		ip   string
+		want bool
+	}{
+		{googlebot, "66.249.66.1", true},
+		{bingbot, "157.55.39.1", true},
+		{yandex, "5.255.253.1", true},
+		// Bingbot's IP claiming to be Googlebot
+		{googlebot, "157.55.39.1", false},
+		{googlebot, "203.0.113.7", false},
+		{googlebot, "203.0.113.8", false},
+		{googlebot, "2001:db8::bad", false},
+		{googlebot, "198.51.100.1", false},
+		// a Compute Engine VM claiming to be Googlebot
+		{googlebot, "34.1.2.3", false},
+		{googlebot, "34.1.2.4", false},
+	}
+	for _, test := range tests {
+		got, err := Verify(context.Background(), test.ua, net.ParseIP(test.ip), r)
+		if err != nil {
+			t.Errorf("%s %s: unexpected error %v", test.ua.Name, test.ip, err)
+		}
+		if got != test.want {
+			t.Errorf("%s %s: expected %v, got %v", test.ua.Name, test.ip, test.want, got)
+		}
+	}
+
+	if _, err := Verify(context.Background(), Parse(`CCBot/2.0 (https://commoncrawl.org/faq/)`), net.ParseIP("66.249.66.1"), r); !errors.Is(err, ErrNotVerifiable) {
+		t.Errorf("expected ErrNotVerifiable, got %v", err)
+	}
+	// user triggered fetchers also run on App Engine, anyone can rent it
+	if _, err := Verify(context.Background(), Parse(`Mozilla/5.0 (compatible; Google-Site-Verification/1.0)`), net.ParseIP("34.1.2.4"), r); !errors.Is(err, ErrNotVerifiable) {
+		t.Errorf("expected ErrNotVerifiable, got %v", err)
+	}
+	if _, err := Verify(context.Background(), Parse(`curl/8.4.0`), net.ParseIP("66.249.66.1"), r); !errors.Is(err, ErrNotVerifiable) {
+		t.Errorf("expected ErrNotVerifiable, got %v", err)
+	}
+}